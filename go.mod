@@ -6,8 +6,8 @@ require (
 	github.com/ProtonMail/go-crypto v1.1.0-alpha.0
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
-	github.com/aws/aws-sdk-go v1.50.35
-	github.com/aws/aws-sdk-go-v2 v1.25.3
+	github.com/aws/aws-sdk-go v1.51.21
+	github.com/aws/aws-sdk-go-v2 v1.30.4
 	github.com/aws/aws-sdk-go-v2/config v1.27.7
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.15.3
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.16.9
@@ -59,7 +59,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.9.1
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.30.4
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.150.0
-	github.com/aws/aws-sdk-go-v2/service/ecr v1.27.2
+	github.com/aws/aws-sdk-go-v2/service/ecr v1.32.2
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.41.2
 	github.com/aws/aws-sdk-go-v2/service/eks v1.41.1
 	github.com/aws/aws-sdk-go-v2/service/elasticache v1.37.2
@@ -83,7 +83,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/keyspaces v1.10.2
 	github.com/aws/aws-sdk-go-v2/service/kinesis v1.27.2
 	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.31.3
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.53.2
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.58.0
 	github.com/aws/aws-sdk-go-v2/service/launchwizard v1.3.2
 	github.com/aws/aws-sdk-go-v2/service/lexmodelsv2 v1.43.1
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.36.2
@@ -147,7 +147,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/wellarchitected v1.29.2
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.38.2
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.2
-	github.com/aws/smithy-go v1.20.1
+	github.com/aws/smithy-go v1.20.4
 	github.com/beevik/etree v1.3.0
 	github.com/davecgh/go-spew v1.1.1
 	github.com/gertd/go-pluralize v0.2.1
@@ -196,10 +196,10 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.1 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.4 // indirect
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.7 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.3 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.3 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.16 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.16 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/dax v1.19.2
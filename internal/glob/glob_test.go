@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glob
+
+import (
+	"testing"
+)
+
+func TestMatchPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/api/index.html", true},
+		{"assets/**", "assets/css/site.css", true},
+		{"assets/**", "public/assets/site.css", false},
+		{"**/.DS_Store", "img/.DS_Store", true},
+		{"a/**/z.txt", "a/z.txt", true},
+		{"a/**/z.txt", "a/b/c/z.txt", true},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file/.txt", false},
+		{"a+b(c).txt", "a+b(c).txt", true},
+		{"tests", "lib/tests", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := MatchPath(testCase.pattern, testCase.name), testCase.expected; got != want {
+			t.Errorf("MatchPath(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, want)
+		}
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"*.pyc", "**/test_*.py", "dir/[a-z]*"} {
+		if err := ValidatePattern(v); err != nil {
+			t.Errorf("%q should be a valid pattern: %s", v, err)
+		}
+	}
+
+	for _, v := range []string{"[", "dir/[a-"} {
+		if err := ValidatePattern(v); err == nil {
+			t.Errorf("%q should be an invalid pattern", v)
+		}
+	}
+}
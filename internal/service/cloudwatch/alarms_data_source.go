@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_cloudwatch_alarms", name="Alarms")
+func dataSourceAlarms() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"alarm_name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"alarm_names"},
+				ValidateFunc:  validation.StringLenBetween(1, 255),
+			},
+			"alarm_names": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      100,
+				ConflictsWith: []string{"alarm_name_prefix"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			"alarm_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.AlarmType](),
+				},
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"composite_alarms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"alarm_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alarm_rule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_updated_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"metric_alarms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"alarm_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_updated_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"state_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.StateValue](),
+			},
+		},
+	}
+}
+
+func dataSourceAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	input := &cloudwatch.DescribeAlarmsInput{}
+
+	if v, ok := d.GetOk("alarm_name_prefix"); ok {
+		input.AlarmNamePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("alarm_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.AlarmNames = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	// DescribeAlarms only returns metric alarms if no alarm types are specified.
+	if v, ok := d.GetOk("alarm_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.AlarmTypes = flex.ExpandStringyValueSet[types.AlarmType](v.(*schema.Set))
+	} else {
+		input.AlarmTypes = enum.EnumValues[types.AlarmType]()
+	}
+
+	if v, ok := d.GetOk("state_value"); ok {
+		input.StateValue = types.StateValue(v.(string))
+	}
+
+	compositeAlarms, metricAlarms, err := findAlarms(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Alarms: %s", err)
+	}
+
+	var arns, names []string
+
+	for _, v := range compositeAlarms {
+		arns = append(arns, aws.ToString(v.AlarmArn))
+		names = append(names, aws.ToString(v.AlarmName))
+	}
+
+	for _, v := range metricAlarms {
+		arns = append(arns, aws.ToString(v.AlarmArn))
+		names = append(names, aws.ToString(v.AlarmName))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("alarm_types", enum.Slice(input.AlarmTypes...))
+	d.Set("arns", arns)
+	if err := d.Set("composite_alarms", flattenCompositeAlarms(compositeAlarms)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting composite_alarms: %s", err)
+	}
+	if err := d.Set("metric_alarms", flattenMetricAlarms(metricAlarms)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_alarms: %s", err)
+	}
+	d.Set("names", names)
+
+	return diags
+}
+
+func findAlarms(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeAlarmsInput) ([]types.CompositeAlarm, []types.MetricAlarm, error) {
+	var compositeAlarms []types.CompositeAlarm
+	var metricAlarms []types.MetricAlarm
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		compositeAlarms = append(compositeAlarms, page.CompositeAlarms...)
+		metricAlarms = append(metricAlarms, page.MetricAlarms...)
+	}
+
+	return compositeAlarms, metricAlarms, nil
+}
+
+func flattenCompositeAlarms(apiObjects []types.CompositeAlarm) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"actions_enabled":         aws.ToBool(apiObject.ActionsEnabled),
+			"alarm_name":              aws.ToString(apiObject.AlarmName),
+			"alarm_rule":              aws.ToString(apiObject.AlarmRule),
+			"arn":                     aws.ToString(apiObject.AlarmArn),
+			"state_reason":            aws.ToString(apiObject.StateReason),
+			"state_updated_timestamp": aws.ToTime(apiObject.StateUpdatedTimestamp).Format(time.RFC3339),
+			"state_value":             apiObject.StateValue,
+		})
+	}
+
+	return tfList
+}
+
+func flattenMetricAlarms(apiObjects []types.MetricAlarm) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"actions_enabled":         aws.ToBool(apiObject.ActionsEnabled),
+			"alarm_name":              aws.ToString(apiObject.AlarmName),
+			"arn":                     aws.ToString(apiObject.AlarmArn),
+			"metric_name":             aws.ToString(apiObject.MetricName),
+			"namespace":               aws.ToString(apiObject.Namespace),
+			"state_reason":            aws.ToString(apiObject.StateReason),
+			"state_updated_timestamp": aws.ToTime(apiObject.StateUpdatedTimestamp).Format(time.RFC3339),
+			"state_value":             apiObject.StateValue,
+		})
+	}
+
+	return tfList
+}
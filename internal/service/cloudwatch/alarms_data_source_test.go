@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchAlarmsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_alarms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "alarm_types.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "composite_alarms.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "composite_alarms.0.arn", "aws_cloudwatch_composite_alarm.test", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "composite_alarms.0.alarm_rule", "aws_cloudwatch_composite_alarm.test", "alarm_rule"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.0.namespace", "AWS/EC2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_alarms.0.state_value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAlarmsDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_alarms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmsDataSourceConfig_alarmTypes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "composite_alarms.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.#", "2"),
+				),
+			},
+			{
+				Config: testAccAlarmsDataSourceConfig_stateValue(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "composite_alarms.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_alarms.#", "0"),
+				),
+			},
+			{
+				Config: testAccAlarmsDataSourceConfig_alarmNames(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "composite_alarms.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAlarmsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_basic(rName), `
+data "aws_cloudwatch_alarms" "test" {
+  alarm_name_prefix = aws_cloudwatch_composite_alarm.test.alarm_name
+}
+`)
+}
+
+func testAccAlarmsDataSourceConfig_alarmTypes(rName string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_basic(rName), `
+data "aws_cloudwatch_alarms" "test" {
+  alarm_name_prefix = aws_cloudwatch_composite_alarm.test.alarm_name
+  alarm_types       = ["MetricAlarm"]
+}
+`)
+}
+
+func testAccAlarmsDataSourceConfig_stateValue(rName string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_basic(rName), `
+data "aws_cloudwatch_alarms" "test" {
+  alarm_name_prefix = aws_cloudwatch_composite_alarm.test.alarm_name
+  state_value       = "ALARM"
+}
+`)
+}
+
+func testAccAlarmsDataSourceConfig_alarmNames(rName string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_basic(rName), fmt.Sprintf(`
+data "aws_cloudwatch_alarms" "test" {
+  alarm_names = [aws_cloudwatch_composite_alarm.test.alarm_name, "%[1]s-missing"]
+}
+`, rName))
+}
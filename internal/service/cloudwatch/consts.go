@@ -30,3 +30,94 @@ func missingData_Values() []string {
 		missingDataNotBreaching,
 	}
 }
+
+const (
+	dashboardWidgetTypeAlarm  = "alarm"
+	dashboardWidgetTypeLog    = "log"
+	dashboardWidgetTypeMetric = "metric"
+	dashboardWidgetTypeText   = "text"
+)
+
+const (
+	dashboardPeriodOverrideAuto    = "auto"
+	dashboardPeriodOverrideInherit = "inherit"
+)
+
+func dashboardPeriodOverride_Values() []string {
+	return []string{
+		dashboardPeriodOverrideAuto,
+		dashboardPeriodOverrideInherit,
+	}
+}
+
+const (
+	dashboardAlarmSortByDefault               = "default"
+	dashboardAlarmSortByStateUpdatedTimestamp = "stateUpdatedTimestamp"
+	dashboardAlarmSortByTimestamp             = "timestamp"
+)
+
+func dashboardAlarmSortBy_Values() []string {
+	return []string{
+		dashboardAlarmSortByDefault,
+		dashboardAlarmSortByStateUpdatedTimestamp,
+		dashboardAlarmSortByTimestamp,
+	}
+}
+
+const (
+	dashboardLogViewBar        = "bar"
+	dashboardLogViewPie        = "pie"
+	dashboardLogViewTable      = "table"
+	dashboardLogViewTimeSeries = "timeSeries"
+)
+
+func dashboardLogView_Values() []string {
+	return []string{
+		dashboardLogViewBar,
+		dashboardLogViewPie,
+		dashboardLogViewTable,
+		dashboardLogViewTimeSeries,
+	}
+}
+
+const (
+	dashboardMetricViewBar         = "bar"
+	dashboardMetricViewGauge       = "gauge"
+	dashboardMetricViewPie         = "pie"
+	dashboardMetricViewSingleValue = "singleValue"
+	dashboardMetricViewTimeSeries  = "timeSeries"
+)
+
+func dashboardMetricView_Values() []string {
+	return []string{
+		dashboardMetricViewBar,
+		dashboardMetricViewGauge,
+		dashboardMetricViewPie,
+		dashboardMetricViewSingleValue,
+		dashboardMetricViewTimeSeries,
+	}
+}
+
+const (
+	dashboardTextBackgroundSolid       = "solid"
+	dashboardTextBackgroundTransparent = "transparent"
+)
+
+func dashboardTextBackground_Values() []string {
+	return []string{
+		dashboardTextBackgroundSolid,
+		dashboardTextBackgroundTransparent,
+	}
+}
+
+const (
+	dashboardYAxisLeft  = "left"
+	dashboardYAxisRight = "right"
+)
+
+func dashboardYAxis_Values() []string {
+	return []string{
+		dashboardYAxisLeft,
+		dashboardYAxisRight,
+	}
+}
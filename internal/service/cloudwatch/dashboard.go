@@ -48,10 +48,7 @@ func resourceDashboard() *schema.Resource {
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
 				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:             normalizeDashboardBody,
 			},
 			"dashboard_name": {
 				Type:         schema.TypeString,
@@ -125,6 +122,12 @@ func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// normalizeDashboardBody normalizes a dashboard body so that equivalent JSON documents are stored identically.
+func normalizeDashboardBody(v interface{}) string {
+	json, _ := structure.NormalizeJsonString(v)
+	return json
+}
+
 func findDashboardByName(ctx context.Context, conn *cloudwatch.Client, name string) (*cloudwatch.GetDashboardOutput, error) {
 	input := &cloudwatch.GetDashboardInput{
 		DashboardName: aws.String(name),
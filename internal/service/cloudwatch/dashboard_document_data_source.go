@@ -0,0 +1,595 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+	dashboardGridWidth          = 24
+	dashboardMaxWidgets         = 500
+	dashboardWidgetMaxAlarms    = 100
+	dashboardWidgetMaxLogGroups = 50
+	dashboardWidgetMaxMetrics   = 500
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func dataSourceDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start"},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dashboardPeriodOverride_Values(), false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: dashboardMaxWidgets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_status": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: dashboardWidgetMaxAlarms,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardAlarmSortBy_Values(), false),
+									},
+									"states": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.StateValue](),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log_query": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: dashboardWidgetMaxLogGroups,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 10000),
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      dashboardLogViewTable,
+										ValidateFunc: validation.StringInSlice(dashboardLogView_Values(), false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_query": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: dashboardWidgetMaxMetrics,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"expression": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 2048),
+												},
+												"id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validMetricQueryID,
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"namespace": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"period": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"y_axis": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(dashboardYAxis_Values(), false),
+												},
+											},
+										},
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  300,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "Average",
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      dashboardMetricViewTimeSeries,
+										ValidateFunc: validation.StringInSlice(dashboardMetricView_Values(), false),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardTextBackground_Values(), false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	body := &dashboardBody{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+		Widgets:        []*dashboardWidget{},
+	}
+
+	for i, tfMapRaw := range d.Get("widget").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		widget, err := expandDashboardWidget(tfMap, meta.(*conns.AWSClient).Region)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "widget.%d: %s", i, err)
+		}
+
+		body.Widgets = append(body.Widgets, widget)
+	}
+
+	layoutDashboardWidgets(body.Widgets)
+
+	output, err := json.Marshal(body)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "marshaling CloudWatch Dashboard document: %s", err)
+	}
+
+	jsonString := normalizeDashboardBody(string(output))
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+type dashboardBody struct {
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Start          string             `json:"start,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Height     int                    `json:"height"`
+	Properties map[string]interface{} `json:"properties"`
+	Type       string                 `json:"type"`
+	Width      int                    `json:"width"`
+	X          int                    `json:"x"`
+	Y          int                    `json:"y"`
+}
+
+// layoutDashboardWidgets places widgets left to right in rows of the dashboard grid,
+// starting a new row below the tallest widget of the current row when a widget doesn't fit.
+func layoutDashboardWidgets(widgets []*dashboardWidget) {
+	var x, y, rowHeight int
+
+	for _, widget := range widgets {
+		if x+widget.Width > dashboardGridWidth {
+			x = 0
+			y += rowHeight
+			rowHeight = 0
+		}
+
+		widget.X = x
+		widget.Y = y
+
+		x += widget.Width
+		rowHeight = max(rowHeight, widget.Height)
+	}
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, defaultRegion string) (*dashboardWidget, error) {
+	widget := &dashboardWidget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	var n int
+	var err error
+
+	if v, ok := tfMap["alarm_status"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardWidgetTypeAlarm
+		widget.Properties = expandDashboardAlarmStatusWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log_query"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardWidgetTypeLog
+		widget.Properties = expandDashboardLogQueryWidgetProperties(v[0].(map[string]interface{}), defaultRegion)
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardWidgetTypeMetric
+		widget.Properties, err = expandDashboardMetricWidgetProperties(v[0].(map[string]interface{}), defaultRegion)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardWidgetTypeText
+		widget.Properties = expandDashboardTextWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if n != 1 {
+		return nil, errors.New("exactly one of alarm_status, log_query, metric or text must be configured")
+	}
+
+	return widget, nil
+}
+
+func expandDashboardAlarmStatusWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"alarms": tfMap["alarms"].([]interface{}),
+	}
+
+	if v, ok := tfMap["sort_by"].(string); ok && v != "" {
+		properties["sortBy"] = v
+	}
+
+	if v, ok := tfMap["states"].(*schema.Set); ok && v.Len() > 0 {
+		states := make([]string, 0, v.Len())
+		for _, v := range v.List() {
+			states = append(states, v.(string))
+		}
+		sort.Strings(states)
+		properties["states"] = states
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	return properties
+}
+
+func expandDashboardLogQueryWidgetProperties(tfMap map[string]interface{}, defaultRegion string) map[string]interface{} {
+	var sources []string
+
+	for _, v := range tfMap["log_group_names"].([]interface{}) {
+		sources = append(sources, fmt.Sprintf("SOURCE '%s'", v.(string)))
+	}
+
+	properties := map[string]interface{}{
+		"query":   strings.Join(append(sources, tfMap["query"].(string)), " | "),
+		"region":  defaultRegion,
+		"stacked": tfMap["stacked"].(bool),
+		"view":    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		properties["region"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	return properties
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, defaultRegion string) (map[string]interface{}, error) {
+	metrics, err := expandDashboardMetrics(tfMap["metric_query"].([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]interface{}{
+		"metrics": metrics,
+		"period":  tfMap["period"].(int),
+		"region":  defaultRegion,
+		"stacked": tfMap["stacked"].(bool),
+		"stat":    tfMap["stat"].(string),
+		"view":    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		properties["region"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	return properties, nil
+}
+
+// expandDashboardMetrics returns the metrics array of a metric widget.
+// Each metric is the namespace, metric name and dimension name/value pairs followed by a rendering options object,
+// and each metric math expression is a single rendering options object.
+func expandDashboardMetrics(tfList []interface{}) ([]interface{}, error) {
+	ids := make(map[string]struct{})
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v := tfMap["id"].(string); v != "" {
+			if _, ok := ids[v]; ok {
+				return nil, fmt.Errorf("metric_query.%d: duplicate id (%s)", i, v)
+			}
+
+			ids[v] = struct{}{}
+		}
+	}
+
+	metrics := []interface{}{}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id := tfMap["id"].(string)
+		options := map[string]interface{}{}
+
+		if id != "" {
+			options["id"] = id
+		}
+
+		if v := tfMap["label"].(string); v != "" {
+			options["label"] = v
+		}
+
+		if !tfMap["visible"].(bool) {
+			options["visible"] = false
+		}
+
+		if v := tfMap["y_axis"].(string); v != "" {
+			options["yAxis"] = v
+		}
+
+		expression := tfMap["expression"].(string)
+		namespace, metricName := tfMap["namespace"].(string), tfMap["metric_name"].(string)
+
+		if expression != "" {
+			if namespace != "" || metricName != "" || len(tfMap["dimensions"].(map[string]interface{})) > 0 {
+				return nil, fmt.Errorf("metric_query.%d: expression cannot be combined with namespace, metric_name or dimensions", i)
+			}
+
+			if id == "" {
+				return nil, fmt.Errorf("metric_query.%d: id is required with expression", i)
+			}
+
+			otherIDs := make(map[string]struct{})
+			for v := range ids {
+				if v != id {
+					otherIDs[v] = struct{}{}
+				}
+			}
+
+			if err := validMetricMathExpression(expression, otherIDs); err != nil {
+				return nil, fmt.Errorf("metric_query.%d: %w", i, err)
+			}
+
+			options["expression"] = expression
+
+			metrics = append(metrics, []interface{}{options})
+
+			continue
+		}
+
+		if namespace == "" || metricName == "" {
+			return nil, fmt.Errorf("metric_query.%d: either expression or namespace and metric_name must be configured", i)
+		}
+
+		if v := tfMap["period"].(int); v > 0 {
+			options["period"] = v
+		}
+
+		if v := tfMap["stat"].(string); v != "" {
+			options["stat"] = v
+		}
+
+		metric := []interface{}{namespace, metricName}
+
+		dimensions := tfMap["dimensions"].(map[string]interface{})
+		names := make([]string, 0, len(dimensions))
+		for k := range dimensions {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, k := range names {
+			metric = append(metric, k, dimensions[k].(string))
+		}
+
+		if len(options) > 0 {
+			metric = append(metric, options)
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	return metrics, nil
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"markdown": tfMap["markdown"].(string),
+	}
+
+	if v, ok := tfMap["background"].(string); ok && v != "" {
+		properties["background"] = v
+	}
+
+	return properties
+}
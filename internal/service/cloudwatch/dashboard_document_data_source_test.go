@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccDashboardDocumentDataSourceExpectedJSON_basic()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_layout(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_layout,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccDashboardDocumentDataSourceExpectedJSON_layout),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_multipleTypes,
+				ExpectError: regexache.MustCompile(`exactly one of alarm_status, log_query, metric or text must be configured`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_unknownID,
+				ExpectError: regexache.MustCompile(`references unknown id \(m3\)`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_duplicateID,
+				ExpectError: regexache.MustCompile(`duplicate id \(m1\)`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+	resourceName := "aws_cloudwatch_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_body", dataSourceName, "json"),
+				),
+			},
+			{
+				Config:   testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_cloudwatch_dashboard_document" "test" {
+  start           = "-PT6H"
+  period_override = "inherit"
+
+  widget {
+    width  = 24
+    height = 2
+
+    text {
+      markdown   = "# Service health"
+      background = "transparent"
+    }
+  }
+
+  widget {
+    width  = 12
+    height = 6
+
+    metric {
+      title = "Error rate"
+      stat  = "Sum"
+
+      metric_query {
+        id          = "errors"
+        namespace   = "AWS/ApplicationELB"
+        metric_name = "HTTPCode_Target_5XX_Count"
+        visible     = false
+
+        dimensions = {
+          LoadBalancer = "app/test/1234567890abcdef"
+        }
+      }
+
+      metric_query {
+        id          = "requests"
+        namespace   = "AWS/ApplicationELB"
+        metric_name = "RequestCount"
+        visible     = false
+
+        dimensions = {
+          LoadBalancer = "app/test/1234567890abcdef"
+        }
+      }
+
+      metric_query {
+        id         = "rate"
+        expression = "100*errors/requests"
+        label      = "Error rate (%)"
+      }
+    }
+  }
+
+  widget {
+    width  = 12
+    height = 6
+
+    log_query {
+      title           = "Recent errors"
+      log_group_names = ["/aws/lambda/test"]
+      query           = "fields @timestamp, @message | filter @message like /ERROR/ | sort @timestamp desc | limit 20"
+    }
+  }
+
+  widget {
+    height = 3
+
+    alarm_status {
+      title   = "Alarms"
+      alarms  = ["arn:${data.aws_partition.current.partition}:cloudwatch:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:alarm:test"]
+      sort_by = "stateUpdatedTimestamp"
+      states  = ["ALARM"]
+    }
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceExpectedJSON_basic() string {
+	return fmt.Sprintf(`{
+  "periodOverride": "inherit",
+  "start": "-PT6H",
+  "widgets": [
+    {
+      "height": 2,
+      "properties": {
+        "background": "transparent",
+        "markdown": "# Service health"
+      },
+      "type": "text",
+      "width": 24,
+      "x": 0,
+      "y": 0
+    },
+    {
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/ApplicationELB", "HTTPCode_Target_5XX_Count", "LoadBalancer", "app/test/1234567890abcdef", {"id": "errors", "visible": false}],
+          ["AWS/ApplicationELB", "RequestCount", "LoadBalancer", "app/test/1234567890abcdef", {"id": "requests", "visible": false}],
+          [{"expression": "100*errors/requests", "id": "rate", "label": "Error rate (%%)"}]
+        ],
+        "period": 300,
+        "region": %[1]q,
+        "stacked": false,
+        "stat": "Sum",
+        "title": "Error rate",
+        "view": "timeSeries"
+      },
+      "type": "metric",
+      "width": 12,
+      "x": 0,
+      "y": 2
+    },
+    {
+      "height": 6,
+      "properties": {
+        "query": "SOURCE '/aws/lambda/test' | fields @timestamp, @message | filter @message like /ERROR/ | sort @timestamp desc | limit 20",
+        "region": %[1]q,
+        "stacked": false,
+        "title": "Recent errors",
+        "view": "table"
+      },
+      "type": "log",
+      "width": 12,
+      "x": 12,
+      "y": 2
+    },
+    {
+      "height": 3,
+      "properties": {
+        "alarms": ["arn:%[2]s:cloudwatch:%[1]s:%[3]s:alarm:test"],
+        "sortBy": "stateUpdatedTimestamp",
+        "states": ["ALARM"],
+        "title": "Alarms"
+      },
+      "type": "alarm",
+      "width": 6,
+      "x": 0,
+      "y": 8
+    }
+  ]
+}`, acctest.Region(), acctest.Partition(), acctest.AccountID())
+}
+
+const testAccDashboardDocumentDataSourceConfig_layout = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width  = 8
+    height = 4
+
+    text {
+      markdown = "a"
+    }
+  }
+
+  widget {
+    width  = 8
+    height = 6
+
+    text {
+      markdown = "b"
+    }
+  }
+
+  widget {
+    width  = 10
+    height = 2
+
+    text {
+      markdown = "c"
+    }
+  }
+
+  widget {
+    width  = 14
+    height = 2
+
+    text {
+      markdown = "d"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceExpectedJSON_layout = `{
+  "widgets": [
+    {"height": 4, "properties": {"markdown": "a"}, "type": "text", "width": 8, "x": 0, "y": 0},
+    {"height": 6, "properties": {"markdown": "b"}, "type": "text", "width": 8, "x": 8, "y": 0},
+    {"height": 2, "properties": {"markdown": "c"}, "type": "text", "width": 10, "x": 0, "y": 6},
+    {"height": 2, "properties": {"markdown": "d"}, "type": "text", "width": 14, "x": 10, "y": 6}
+  ]
+}`
+
+const testAccDashboardDocumentDataSourceConfig_multipleTypes = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    text {
+      markdown = "a"
+    }
+
+    log_query {
+      log_group_names = ["/aws/lambda/test"]
+      query           = "fields @message"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_unknownID = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric_query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "m1+m3"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_duplicateID = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric_query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+
+      metric_query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "NetworkIn"
+      }
+    }
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+
+    metric {
+      title = "CPU"
+
+      metric_query {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+
+        dimensions = {
+          InstanceId = "i-012345"
+        }
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    text {
+      markdown = "Hello world"
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudwatch_metric_data", name="Metric Data")
+func dataSourceMetricData() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMetricDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_datapoints": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"metric_data_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"messages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeFloat,
+							},
+						},
+					},
+				},
+			},
+			"metric_query": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validMetricQueryID,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 255),
+											validation.StringMatch(regexache.MustCompile(`[^:].*`), "must not contain colon characters"),
+										),
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"unit": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.StandardUnit](),
+									},
+								},
+							},
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{1, 5, 10, 30}),
+								validation.IntDivisibleBy(60),
+							),
+						},
+						"return_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"scan_by": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.ScanByTimestampDescending),
+				ValidateDiagFunc: enum.Validate[types.ScanBy](),
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+	}
+}
+
+func dataSourceMetricDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	endTime, _ := time.Parse(time.RFC3339, d.Get("end_time").(string))
+
+	if !startTime.Before(endTime) {
+		return sdkdiag.AppendErrorf(diags, "start_time (%s) must be before end_time (%s)", d.Get("start_time").(string), d.Get("end_time").(string))
+	}
+
+	for i, tfMapRaw := range d.Get("metric_query").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if hasExpression, hasMetric := tfMap["expression"].(string) != "", len(tfMap["metric"].([]interface{})) > 0; hasExpression == hasMetric {
+			return sdkdiag.AppendErrorf(diags, "metric_query.%d: exactly one of expression or metric must be configured", i)
+		}
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		EndTime:           aws.Time(endTime),
+		MetricDataQueries: expandMetricAlarmMetrics(d.Get("metric_query").([]interface{})),
+		ScanBy:            types.ScanBy(d.Get("scan_by").(string)),
+		StartTime:         aws.Time(startTime),
+	}
+
+	if v, ok := d.GetOk("max_datapoints"); ok {
+		input.MaxDatapoints = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := findMetricData(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Metric Data: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("metric_data_results", flattenMetricDataResults(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_data_results: %s", err)
+	}
+
+	return diags
+}
+
+// findMetricData returns the results of all pages, with the timestamps and values of each query merged.
+func findMetricData(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.GetMetricDataInput) ([]types.MetricDataResult, error) {
+	var output []types.MetricDataResult
+	indexes := make(map[string]int)
+
+	pages := cloudwatch.NewGetMetricDataPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricDataResults {
+			id := aws.ToString(v.Id)
+
+			if i, ok := indexes[id]; ok {
+				output[i].Timestamps = append(output[i].Timestamps, v.Timestamps...)
+				output[i].Values = append(output[i].Values, v.Values...)
+				output[i].Messages = append(output[i].Messages, v.Messages...)
+				output[i].StatusCode = v.StatusCode
+
+				continue
+			}
+
+			indexes[id] = len(output)
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
+
+func flattenMetricDataResults(apiObjects []types.MetricDataResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var messages []string
+		for _, v := range apiObject.Messages {
+			messages = append(messages, aws.ToString(v.Value))
+		}
+
+		var timestamps []string
+		for _, v := range apiObject.Timestamps {
+			timestamps = append(timestamps, v.Format(time.RFC3339))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":          aws.ToString(apiObject.Id),
+			"label":       aws.ToString(apiObject.Label),
+			"messages":    messages,
+			"status_code": apiObject.StatusCode,
+			"timestamps":  timestamps,
+			"values":      apiObject.Values,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchMetricDataDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricDataDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "scan_by", "TimestampDescending"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.id", "m1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.label", "CPU"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.status_code", "Complete"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricDataDataSource_expression(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricDataDataSourceConfig_expression,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.id", "e1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.status_code", "Complete"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricDataDataSource_invalidTimeRange(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricDataDataSourceConfig_invalidTimeRange,
+				ExpectError: regexache.MustCompile(`must be before end_time`),
+			},
+		},
+	})
+}
+
+const testAccMetricDataDataSourceConfig_basic = `
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = "2024-01-01T00:00:00Z"
+  end_time   = "2024-01-01T01:00:00Z"
+
+  metric_query {
+    id    = "m1"
+    label = "CPU"
+
+    metric {
+      namespace   = "AWS/EC2"
+      metric_name = "CPUUtilization"
+      period      = 300
+      stat        = "Average"
+
+      dimensions = {
+        InstanceId = "i-abcd1234"
+      }
+    }
+  }
+}
+`
+
+const testAccMetricDataDataSourceConfig_expression = `
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = "2024-01-01T00:00:00Z"
+  end_time   = "2024-01-01T01:00:00Z"
+
+  metric_query {
+    id          = "m1"
+    return_data = false
+
+    metric {
+      namespace   = "AWS/EC2"
+      metric_name = "CPUUtilization"
+      period      = 300
+      stat        = "Maximum"
+
+      dimensions = {
+        InstanceId = "i-abcd1234"
+      }
+    }
+  }
+
+  metric_query {
+    id         = "e1"
+    expression = "FILL(m1, 0) * 2"
+    label      = "Doubled CPU"
+  }
+}
+`
+
+const testAccMetricDataDataSourceConfig_invalidTimeRange = `
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = "2024-01-01T01:00:00Z"
+  end_time   = "2024-01-01T00:00:00Z"
+
+  metric_query {
+    id = "m1"
+
+    metric {
+      namespace   = "AWS/EC2"
+      metric_name = "CPUUtilization"
+      period      = 300
+      stat        = "Average"
+    }
+  }
+}
+`
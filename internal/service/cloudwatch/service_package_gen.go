@@ -23,7 +23,23 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAlarms,
+			TypeName: "aws_cloudwatch_alarms",
+			Name:     "Alarms",
+		},
+		{
+			Factory:  dataSourceDashboardDocument,
+			TypeName: "aws_cloudwatch_dashboard_document",
+			Name:     "Dashboard Document",
+		},
+		{
+			Factory:  dataSourceMetricData,
+			TypeName: "aws_cloudwatch_metric_data",
+			Name:     "Metric Data",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -5,6 +5,7 @@ package cloudwatch
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 )
@@ -40,3 +41,71 @@ func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error)
 
 	return
 }
+
+func validMetricQueryID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 255 characters: %q", k, value))
+	}
+
+	// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_MetricDataQuery.html
+	pattern := `^[a-z][0-9A-Za-z_]*$`
+	if !regexache.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must start with a lowercase letter and contain only letters, numbers and underscores: %q",
+			k, value))
+	}
+
+	return
+}
+
+var (
+	metricMathIdentifierRegexp    = regexache.MustCompile(`\b[a-z][0-9A-Za-z_]*\b`)
+	metricMathStringLiteralRegexp = regexache.MustCompile(`'[^']*'|"[^"]*"`)
+	metricsInsightsQueryRegexp    = regexache.MustCompile(`(?i)^\s*SELECT\b`)
+)
+
+// validMetricMathExpression checks that a metric math expression has balanced brackets
+// and only references the ids of other metrics and expressions in the same widget.
+// Metric math functions are upper case, so lower case identifiers are references to ids.
+// Metrics Insights queries name metrics and dimensions rather than ids, so only their brackets are checked.
+func validMetricMathExpression(expression string, ids map[string]struct{}) error {
+	// Search expressions and labels are quoted and are not validated.
+	stripped := metricMathStringLiteralRegexp.ReplaceAllString(expression, "''")
+
+	if strings.Count(stripped, "'")%2 != 0 || strings.Count(stripped, `"`)%2 != 0 {
+		return fmt.Errorf("metric math expression (%s) has an unterminated string", expression)
+	}
+
+	var stack []rune
+	pairs := map[rune]rune{')': '(', ']': '['}
+
+	for _, r := range stripped {
+		switch r {
+		case '(', '[':
+			stack = append(stack, r)
+		case ')', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[r] {
+				return fmt.Errorf("metric math expression (%s) has unbalanced brackets", expression)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) > 0 {
+		return fmt.Errorf("metric math expression (%s) has unbalanced brackets", expression)
+	}
+
+	if metricsInsightsQueryRegexp.MatchString(stripped) {
+		return nil
+	}
+
+	for _, id := range metricMathIdentifierRegexp.FindAllString(stripped, -1) {
+		if _, ok := ids[id]; !ok {
+			return fmt.Errorf("metric math expression (%s) references unknown id (%s)", expression, id)
+		}
+	}
+
+	return nil
+}
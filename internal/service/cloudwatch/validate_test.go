@@ -72,3 +72,77 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidMetricQueryID(t *testing.T) {
+	t.Parallel()
+
+	validIDs := []string{
+		"m1",
+		"e1",
+		"errorRate",
+		"error_rate_5xx",
+	}
+	for _, v := range validIDs {
+		_, errors := validMetricQueryID(v, "id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudWatch metric query ID: %q", v, errors)
+		}
+	}
+
+	invalidIDs := []string{
+		"",
+		"M1",
+		"1m",
+		"_m1",
+		"m-1",
+		strings.Repeat("m", 256), // > 255
+	}
+	for _, v := range invalidIDs {
+		_, errors := validMetricQueryID(v, "id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CloudWatch metric query ID", v)
+		}
+	}
+}
+
+func TestValidMetricMathExpression(t *testing.T) {
+	t.Parallel()
+
+	ids := map[string]struct{}{
+		"m1":     {},
+		"m2":     {},
+		"errors": {},
+	}
+
+	validExpressions := []string{
+		"m1+m2",
+		"100*errors/m1",
+		"SUM([m1, m2])",
+		"FILL(m1, 0) * 1e3",
+		"IF(m1 > 10, m1, 0)",
+		"SEARCH('{AWS/EC2,InstanceId} MetricName=\"CPUUtilization\" m3', 'Average', 300)",
+		"AVG(METRICS(\"m\"))",
+		"SELECT AVG(latency) FROM SCHEMA(MyApp, service)",
+		"select max(CPUUtilization) FROM \"AWS/EC2\" WHERE InstanceType = 't3.micro' GROUP BY InstanceId",
+	}
+	for _, v := range validExpressions {
+		if err := validMetricMathExpression(v, ids); err != nil {
+			t.Fatalf("%q should be a valid metric math expression: %s", v, err)
+		}
+	}
+
+	invalidExpressions := []string{
+		"m1+m3",
+		"SUM([m1, m2)",
+		"(m1+m2",
+		"m1+m2)",
+		"SEARCH('{AWS/EC2,InstanceId}, 'Average', 300)",
+		"SELECT AVG(latency) FROM SCHEMA(MyApp, service",
+		"m3 + select",
+	}
+	for _, v := range invalidExpressions {
+		if err := validMetricMathExpression(v, ids); err == nil {
+			t.Fatalf("%q should be an invalid metric math expression", v)
+		}
+	}
+}
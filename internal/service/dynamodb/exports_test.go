@@ -6,8 +6,15 @@ package dynamodb
 // Exports for use in tests only.
 var (
 	ResourceKinesisStreamingDestination = resourceKinesisStreamingDestination
+	ResourceResourcePolicy              = resourceResourcePolicy
+	ResourceTableExport                 = resourceTableExport
 	ResourceTag                         = resourceTag
 
+	ExpandTableItems                             = expandTableItems
+	NormalizeTableItemNumber                     = normalizeTableItemNumber
+	TableItemHash                                = tableItemHash
 	FindKinesisDataStreamDestinationByTwoPartKey = findKinesisDataStreamDestinationByTwoPartKey
+	FindResourcePolicyByARN                      = findResourcePolicyByARN
+	FindTableExportByARN                         = findTableExportByARN
 	ListTags                                     = listTags
 )
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Pass as ExpectedRevisionId to only put a policy if the resource doesn't already have one.
+	resourcePolicyRevisionIDNoPolicy = "NO_POLICY"
+
+	resourcePolicyPropagationTimeout = 2 * time.Minute
+)
+
+// @SDKResource("aws_dynamodb_resource_policy", name="Resource Policy")
+func resourceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourcePolicyPut,
+		ReadWithoutTimeout:   resourceResourcePolicyRead,
+		UpdateWithoutTimeout: resourceResourcePolicyPut,
+		DeleteWithoutTimeout: resourceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"confirm_remove_self_resource_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				ValidateFunc:          validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourcePolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resourceARN := d.Get("resource_arn").(string)
+	input := &dynamodb.PutResourcePolicyInput{
+		ConfirmRemoveSelfResourceAccess: aws.Bool(d.Get("confirm_remove_self_resource_access").(bool)),
+		Policy:                          aws.String(policy),
+		ResourceArn:                     aws.String(resourceARN),
+	}
+
+	// Optimistic concurrency: only create a policy if none exists, and only replace the revision last read.
+	if d.IsNewResource() {
+		input.ExpectedRevisionId = aws.String(resourcePolicyRevisionIDNoPolicy)
+	} else if v, ok := d.GetOk("revision_id"); ok {
+		input.ExpectedRevisionId = aws.String(v.(string))
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	// The table or stream can't be modified while it's being created or updated.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.PutResourcePolicyWithContext(ctx, input)
+	}, dynamodb.ErrCodeResourceInUseException)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodePolicyNotFoundException) {
+		if d.IsNewResource() {
+			return sdkdiag.AppendErrorf(diags, "putting DynamoDB Resource Policy (%s): a policy is already attached to the resource, import it instead: %s", resourceARN, err)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "putting DynamoDB Resource Policy (%s): the policy has been modified outside of Terraform since revision %s, refresh and try again: %s", resourceARN, d.Get("revision_id").(string), err)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting DynamoDB Resource Policy (%s): %s", resourceARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(resourceARN)
+	}
+
+	revisionID := aws.StringValue(outputRaw.(*dynamodb.PutResourcePolicyOutput).RevisionId)
+
+	if _, err := waitResourcePolicyRevision(ctx, conn, d.Id(), revisionID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Resource Policy (%s) revision (%s): %s", d.Id(), revisionID, err)
+	}
+
+	return append(diags, resourceResourcePolicyRead(ctx, d, meta)...)
+}
+
+func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	output, err := findResourcePolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), aws.StringValue(output.Policy))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("resource_arn", d.Id())
+	d.Set("revision_id", output.RevisionId)
+
+	return diags
+}
+
+func resourceResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	log.Printf("[INFO] Deleting DynamoDB Resource Policy: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteResourcePolicyWithContext(ctx, &dynamodb.DeleteResourcePolicyInput{
+			ResourceArn: aws.String(d.Id()),
+		})
+	}, dynamodb.ErrCodeResourceInUseException)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodePolicyNotFoundException, dynamodb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	if _, err := tfresource.RetryUntilNotFound(ctx, resourcePolicyPropagationTimeout, func() (interface{}, error) {
+		return findResourcePolicyByARN(ctx, conn, d.Id())
+	}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Resource Policy (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findResourcePolicyByARN(ctx context.Context, conn *dynamodb.DynamoDB, arn string) (*dynamodb.GetResourcePolicyOutput, error) {
+	input := &dynamodb.GetResourcePolicyInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetResourcePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodePolicyNotFoundException, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// waitResourcePolicyRevision waits for the specified policy revision to become visible, as policy changes are eventually consistent.
+func waitResourcePolicyRevision(ctx context.Context, conn *dynamodb.DynamoDB, arn, revisionID string) (string, error) {
+	return tfresource.RetryUntilEqual(ctx, resourcePolicyPropagationTimeout, revisionID, func() (string, error) {
+		output, err := findResourcePolicyByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return "", nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		return aws.StringValue(output.RevisionId), nil
+	})
+}
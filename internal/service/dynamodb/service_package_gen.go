@@ -53,6 +53,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_dynamodb_kinesis_streaming_destination",
 			Name:     "Kinesis Streaming Destination",
 		},
+		{
+			Factory:  resourceResourcePolicy,
+			TypeName: "aws_dynamodb_resource_policy",
+			Name:     "Resource Policy",
+		},
 		{
 			Factory:  ResourceTable,
 			TypeName: "aws_dynamodb_table",
@@ -61,10 +66,20 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  resourceTableExport,
+			TypeName: "aws_dynamodb_table_export",
+			Name:     "Table Export",
+		},
 		{
 			Factory:  ResourceTableItem,
 			TypeName: "aws_dynamodb_table_item",
 		},
+		{
+			Factory:  resourceTableItems,
+			TypeName: "aws_dynamodb_table_items",
+			Name:     "Table Items",
+		},
 		{
 			Factory:  ResourceTableReplica,
 			TypeName: "aws_dynamodb_table_replica",
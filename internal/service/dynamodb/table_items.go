@@ -0,0 +1,663 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html.
+	batchWriteItemMaxItems = 25
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+	batchGetItemMaxKeys = 100
+)
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffTableItems,
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTableItem,
+				},
+				Set: tableItemHash,
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// tableItem is a DynamoDB item along with its primary key.
+type tableItem struct {
+	key        string
+	attributes map[string]*dynamodb.AttributeValue
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	items, err := expandTableItems(d.Get("items").(*schema.Set).List(), d.Get("hash_key").(string), d.Get("range_key").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	var requests []*dynamodb.WriteRequest
+	for _, item := range items {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: item.attributes},
+		})
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	items, err := expandTableItems(d.Get("items").(*schema.Set).List(), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", d.Id(), err)
+	}
+
+	var keys []map[string]*dynamodb.AttributeValue
+	for _, item := range items {
+		keys = append(keys, BuildTableItemQueryKey(item.attributes, hashKey, rangeKey))
+	}
+
+	found, err := findTableItemsByKeys(ctx, conn, tableName, keys)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing Items from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", d.Id(), err)
+	}
+
+	remoteItems := make(map[string]map[string]*dynamodb.AttributeValue, len(found))
+	for _, v := range found {
+		remoteItems[buildTableItemKey(v, hashKey, rangeKey)] = v
+	}
+
+	// Each item is checked for drift individually: changed items are replaced with their current value, deleted items are dropped.
+	tfList := d.Get("items").(*schema.Set).List()
+	var newItems []interface{}
+	for i, item := range items {
+		remote, ok := remoteItems[item.key]
+
+		if !ok {
+			log.Printf("[WARN] DynamoDB Table (%s) Item (%s) not found, removing from state", d.Id(), item.key)
+			continue
+		}
+
+		if reflect.DeepEqual(remote, item.attributes) {
+			newItems = append(newItems, tfList[i])
+			continue
+		}
+
+		v, err := flattenTableItemAttributes(remote)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", d.Id(), err)
+		}
+
+		newItems = append(newItems, v)
+	}
+
+	d.Set("hash_key", hashKey)
+	d.Set("items", newItems)
+	d.Set("range_key", rangeKey)
+	d.Set("table_name", tableName)
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	if d.HasChange("items") {
+		tableName := d.Get("table_name").(string)
+		hashKey := d.Get("hash_key").(string)
+		rangeKey := d.Get("range_key").(string)
+		o, n := d.GetChange("items")
+
+		oldItems, err := expandTableItems(o.(*schema.Set).List(), hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: %s", d.Id(), err)
+		}
+
+		newItems, err := expandTableItems(n.(*schema.Set).List(), hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: %s", d.Id(), err)
+		}
+
+		if err := batchWriteTableItems(ctx, conn, tableName, tableItemsWriteRequests(oldItems, newItems, hashKey, rangeKey), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	items, err := expandTableItems(d.Get("items").(*schema.Set).List(), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s) Items: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting %d DynamoDB Table (%s) Items", len(items), d.Id())
+	err = batchWriteTableItems(ctx, conn, d.Get("table_name").(string), tableItemsWriteRequests(items, nil, hashKey, rangeKey), d.Timeout(schema.TimeoutDelete))
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s) Items: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func customizeDiffTableItems(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("items") || !d.NewValueKnown("hash_key") || !d.NewValueKnown("range_key") {
+		return nil
+	}
+
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	// Unknown set elements are returned as empty strings.
+	var tfList []interface{}
+	for _, v := range d.Get("items").(*schema.Set).List() {
+		if v, ok := v.(string); ok && v != "" {
+			tfList = append(tfList, v)
+		}
+	}
+
+	items, err := expandTableItems(tfList, hashKey, rangeKey)
+	if err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("table_name") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	// The table may be created in the same apply.
+	table, err := FindTableByName(ctx, conn, d.Get("table_name").(string))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading DynamoDB Table (%s): %w", d.Get("table_name").(string), err)
+	}
+
+	return validateTableItemsKeySchema(table, items, hashKey, rangeKey)
+}
+
+// expandTableItems parses each item's typed JSON and checks that it has a unique primary key.
+func expandTableItems(tfList []interface{}, hashKey, rangeKey string) ([]tableItem, error) {
+	items := make([]tableItem, 0, len(tfList))
+	seen := make(map[string]int, len(tfList))
+
+	for i, tfListRaw := range tfList {
+		v, ok := tfListRaw.(string)
+		if !ok {
+			continue
+		}
+
+		attributes, err := ExpandTableItemAttributes(v)
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+
+		if err := validateTableItemAttributes(attributes); err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+
+		normalizeTableItemAttributes(attributes)
+
+		for _, k := range []string{hashKey, rangeKey} {
+			if k == "" {
+				continue
+			}
+
+			v, ok := attributes[k]
+
+			if !ok {
+				return nil, fmt.Errorf("items[%d]: missing key attribute %q", i, k)
+			}
+
+			if v.B == nil && v.N == nil && v.S == nil {
+				return nil, fmt.Errorf("items[%d]: key attribute %q must be of type B, N or S", i, k)
+			}
+		}
+
+		key := buildTableItemKey(attributes, hashKey, rangeKey)
+
+		if j, ok := seen[key]; ok {
+			return nil, fmt.Errorf("items[%d]: duplicate primary key, already used by items[%d]", i, j)
+		}
+		seen[key] = i
+
+		items = append(items, tableItem{
+			key:        key,
+			attributes: attributes,
+		})
+	}
+
+	return items, nil
+}
+
+// validateTableItemAttributes checks that each attribute value, including nested values, sets exactly one data type.
+func validateTableItemAttributes(attributes map[string]*dynamodb.AttributeValue) error {
+	for k, v := range attributes {
+		if err := validateTableItemAttributeValue(v); err != nil {
+			return fmt.Errorf("attribute %q: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+func validateTableItemAttributeValue(v *dynamodb.AttributeValue) error {
+	if v == nil {
+		return errors.New("value must not be null")
+	}
+
+	var types []string
+
+	if v.B != nil {
+		types = append(types, "B")
+	}
+	if v.BOOL != nil {
+		types = append(types, "BOOL")
+	}
+	if v.BS != nil {
+		types = append(types, "BS")
+	}
+	if v.L != nil {
+		types = append(types, "L")
+
+		for i, v := range v.L {
+			if err := validateTableItemAttributeValue(v); err != nil {
+				return fmt.Errorf("L[%d]: %w", i, err)
+			}
+		}
+	}
+	if v.M != nil {
+		types = append(types, "M")
+
+		if err := validateTableItemAttributes(v.M); err != nil {
+			return fmt.Errorf("M: %w", err)
+		}
+	}
+	if v.N != nil {
+		types = append(types, "N")
+
+		if err := validateTableItemNumber(aws.StringValue(v.N)); err != nil {
+			return err
+		}
+	}
+	if v.NS != nil {
+		types = append(types, "NS")
+
+		for _, v := range v.NS {
+			if err := validateTableItemNumber(aws.StringValue(v)); err != nil {
+				return err
+			}
+		}
+	}
+	if v.NULL != nil {
+		types = append(types, "NULL")
+	}
+	if v.S != nil {
+		types = append(types, "S")
+	}
+	if v.SS != nil {
+		types = append(types, "SS")
+	}
+
+	switch len(types) {
+	case 0:
+		return errors.New("value must set one of B, BOOL, BS, L, M, N, NS, NULL, S or SS")
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("value must set exactly one data type, got %q", types)
+	}
+}
+
+func validateTableItemNumber(v string) error {
+	if _, err := strconv.ParseFloat(v, 64); err != nil {
+		return fmt.Errorf("%q is not a valid number", v)
+	}
+
+	return nil
+}
+
+// normalizeTableItemAttributes rewrites each number, including nested numbers, in the form returned by DynamoDB.
+func normalizeTableItemAttributes(attributes map[string]*dynamodb.AttributeValue) {
+	for _, v := range attributes {
+		normalizeTableItemAttributeValue(v)
+	}
+}
+
+func normalizeTableItemAttributeValue(v *dynamodb.AttributeValue) {
+	if v == nil {
+		return
+	}
+
+	if v.N != nil {
+		v.N = aws.String(normalizeTableItemNumber(aws.StringValue(v.N)))
+	}
+
+	for i, n := range v.NS {
+		v.NS[i] = aws.String(normalizeTableItemNumber(aws.StringValue(n)))
+	}
+
+	for _, v := range v.L {
+		normalizeTableItemAttributeValue(v)
+	}
+
+	normalizeTableItemAttributes(v.M)
+}
+
+// normalizeTableItemNumber returns the number in the form returned by DynamoDB, without exponent and without leading or trailing zeros.
+// For example, "01.50" and "1.5e0" are both returned as "1.5". Invalid numbers are returned unchanged.
+func normalizeTableItemNumber(v string) string {
+	if validateTableItemNumber(v) != nil {
+		return v
+	}
+
+	r, ok := new(big.Rat).SetString(v)
+	if !ok {
+		return v
+	}
+
+	// The denominator of a decimal number only has factors 2 and 5, so it has a finite number of decimal places.
+	prec := 0
+	for x := new(big.Rat).Set(r); !x.IsInt(); prec++ {
+		x.Mul(x, big.NewRat(10, 1))
+	}
+
+	return r.FloatString(prec)
+}
+
+// tableItemHash hashes the normalized attributes of an item, so that items that are equivalent JSON or only differ in the form of their numbers hash to the same value.
+func tableItemHash(v interface{}) int {
+	attributes, err := ExpandTableItemAttributes(v.(string))
+	if err != nil {
+		return create.StringHashcode(v.(string))
+	}
+
+	normalizeTableItemAttributes(attributes)
+
+	b, err := json.Marshal(attributes)
+	if err != nil {
+		return create.StringHashcode(v.(string))
+	}
+
+	return create.StringHashcode(string(b))
+}
+
+// validateTableItemsKeySchema checks the configured keys and each item's key attribute types against the table's key schema.
+func validateTableItemsKeySchema(table *dynamodb.TableDescription, items []tableItem, hashKey, rangeKey string) error {
+	var tableHashKey, tableRangeKey string
+	for _, v := range table.KeySchema {
+		switch aws.StringValue(v.KeyType) {
+		case dynamodb.KeyTypeHash:
+			tableHashKey = aws.StringValue(v.AttributeName)
+		case dynamodb.KeyTypeRange:
+			tableRangeKey = aws.StringValue(v.AttributeName)
+		}
+	}
+
+	tableName := aws.StringValue(table.TableName)
+
+	if hashKey != tableHashKey {
+		return fmt.Errorf("hash_key (%s) does not match DynamoDB Table (%s) hash key (%s)", hashKey, tableName, tableHashKey)
+	}
+
+	if rangeKey != tableRangeKey {
+		return fmt.Errorf("range_key (%s) does not match DynamoDB Table (%s) range key (%s)", rangeKey, tableName, tableRangeKey)
+	}
+
+	types := make(map[string]string, len(table.AttributeDefinitions))
+	for _, v := range table.AttributeDefinitions {
+		types[aws.StringValue(v.AttributeName)] = aws.StringValue(v.AttributeType)
+	}
+
+	for i, item := range items {
+		for _, k := range []string{hashKey, rangeKey} {
+			if k == "" {
+				continue
+			}
+
+			v := item.attributes[k]
+			var got string
+			switch {
+			case v.B != nil:
+				got = dynamodb.ScalarAttributeTypeB
+			case v.N != nil:
+				got = dynamodb.ScalarAttributeTypeN
+			case v.S != nil:
+				got = dynamodb.ScalarAttributeTypeS
+			}
+
+			if want := types[k]; got != want {
+				return fmt.Errorf("items[%d]: key attribute %q is of type %s, DynamoDB Table (%s) expects %s", i, k, got, tableName, want)
+			}
+		}
+	}
+
+	return nil
+}
+
+// tableItemsWriteRequests returns the write requests needed to go from the old items to the new items.
+func tableItemsWriteRequests(oldItems, newItems []tableItem, hashKey, rangeKey string) []*dynamodb.WriteRequest {
+	var requests []*dynamodb.WriteRequest
+
+	oldAttributes := make(map[string]map[string]*dynamodb.AttributeValue, len(oldItems))
+	for _, item := range oldItems {
+		oldAttributes[item.key] = item.attributes
+	}
+
+	newKeys := make(map[string]struct{}, len(newItems))
+	for _, item := range newItems {
+		newKeys[item.key] = struct{}{}
+
+		if v, ok := oldAttributes[item.key]; ok && reflect.DeepEqual(v, item.attributes) {
+			continue
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: item.attributes},
+		})
+	}
+
+	for _, item := range oldItems {
+		if _, ok := newKeys[item.key]; ok {
+			continue
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: BuildTableItemQueryKey(item.attributes, hashKey, rangeKey)},
+		})
+	}
+
+	return requests
+}
+
+// batchWriteTableItems writes items in batches, retrying any unprocessed items with backoff.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, requests []*dynamodb.WriteRequest, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	for _, chunk := range tfslices.Chunks(requests, batchWriteItemMaxItems) {
+		input := &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{
+				tableName: chunk,
+			},
+		}
+
+		err := tfresource.Retry(ctx, deadline.Remaining(), func() *retry.RetryError {
+			output, err := conn.BatchWriteItemWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeProvisionedThroughputExceededException, dynamodb.ErrCodeRequestLimitExceeded) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			if v := output.UnprocessedItems[tableName]; len(v) > 0 {
+				input.RequestItems = output.UnprocessedItems
+				return retry.RetryableError(fmt.Errorf("%d unprocessed items", len(v)))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys reads the items with the specified keys in batches, retrying any unprocessed keys.
+// Items that don't exist aren't returned.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, keys []map[string]*dynamodb.AttributeValue) ([]map[string]*dynamodb.AttributeValue, error) {
+	const (
+		timeout = 5 * time.Minute
+	)
+	var items []map[string]*dynamodb.AttributeValue
+
+	for _, chunk := range tfslices.Chunks(keys, batchGetItemMaxKeys) {
+		input := &dynamodb.BatchGetItemInput{
+			RequestItems: map[string]*dynamodb.KeysAndAttributes{
+				tableName: {
+					ConsistentRead: aws.Bool(true),
+					Keys:           chunk,
+				},
+			},
+		}
+
+		err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+			output, err := conn.BatchGetItemWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+				return retry.NonRetryableError(&retry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				})
+			}
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeProvisionedThroughputExceededException, dynamodb.ErrCodeRequestLimitExceeded) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			if v, ok := output.UnprocessedKeys[tableName]; ok && len(v.Keys) > 0 {
+				input.RequestItems = output.UnprocessedKeys
+				return retry.RetryableError(fmt.Errorf("%d unprocessed keys", len(v.Keys)))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+func buildTableItemKey(attributes map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) string {
+	return buildTableItemID("", hashKey, rangeKey, attributes)
+}
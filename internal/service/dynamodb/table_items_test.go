@@ -0,0 +1,575 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandTableItems(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		items         []interface{}
+		rangeKey      string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "valid",
+			items: []interface{}{`{"pk": {"S": "a"}, "n": {"N": "1.5"}}`, `{"pk": {"S": "b"}, "l": {"L": [{"BOOL": true}]}}`},
+		},
+		{
+			name:     "valid range key",
+			items:    []interface{}{`{"pk": {"S": "a"}, "sk": {"N": "1"}}`, `{"pk": {"S": "a"}, "sk": {"N": "2"}}`},
+			rangeKey: "sk",
+		},
+		{
+			name:          "invalid JSON",
+			items:         []interface{}{`{"pk": "a"}`},
+			expectedError: regexache.MustCompile(`items\[0\]: Decoding failed`),
+		},
+		{
+			name:          "missing hash key",
+			items:         []interface{}{`{"id": {"S": "a"}}`},
+			expectedError: regexache.MustCompile(`items\[0\]: missing key attribute "pk"`),
+		},
+		{
+			name:          "missing range key",
+			items:         []interface{}{`{"pk": {"S": "a"}}`},
+			rangeKey:      "sk",
+			expectedError: regexache.MustCompile(`items\[0\]: missing key attribute "sk"`),
+		},
+		{
+			name:          "non-scalar key",
+			items:         []interface{}{`{"pk": {"SS": ["a"]}}`},
+			expectedError: regexache.MustCompile(`key attribute "pk" must be of type B, N or S`),
+		},
+		{
+			name:          "duplicate key",
+			items:         []interface{}{`{"pk": {"S": "a"}}`, `{"pk": {"S": "b"}}`, `{"pk": {"S": "a"}, "x": {"S": "y"}}`},
+			expectedError: regexache.MustCompile(`items\[2\]: duplicate primary key, already used by items\[0\]`),
+		},
+		{
+			name:          "untyped value",
+			items:         []interface{}{`{"pk": {"S": "a"}, "x": {}}`},
+			expectedError: regexache.MustCompile(`attribute "x": value must set one of`),
+		},
+		{
+			name:          "multiple types",
+			items:         []interface{}{`{"pk": {"S": "a"}, "x": {"S": "b", "N": "1"}}`},
+			expectedError: regexache.MustCompile(`attribute "x": value must set exactly one data type`),
+		},
+		{
+			name:          "nested untyped value",
+			items:         []interface{}{`{"pk": {"S": "a"}, "x": {"M": {"y": {"L": [{}]}}}}`},
+			expectedError: regexache.MustCompile(`attribute "x": M: attribute "y": L\[0\]: value must set one of`),
+		},
+		{
+			name:          "duplicate number key",
+			items:         []interface{}{`{"pk": {"N": "1"}}`, `{"pk": {"N": "1.0"}}`},
+			expectedError: regexache.MustCompile(`items\[1\]: duplicate primary key, already used by items\[0\]`),
+		},
+		{
+			name:          "invalid number",
+			items:         []interface{}{`{"pk": {"S": "a"}, "x": {"N": "one"}}`},
+			expectedError: regexache.MustCompile(`"one" is not a valid number`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			items, err := tfdynamodb.ExpandTableItems(testCase.items, "pk", testCase.rangeKey)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got, want := len(items), len(testCase.items); got != want {
+					t.Errorf("got %d items, want %d", got, want)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestNormalizeTableItemNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"1", "1"},
+		{"1.0", "1"},
+		{"01.50", "1.5"},
+		{"-0", "0"},
+		{"-0.25", "-0.25"},
+		{"1.5e0", "1.5"},
+		{"1E2", "100"},
+		{"12.5e-3", "0.0125"},
+		{"one", "one"},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := tfdynamodb.NormalizeTableItemNumber(testCase.input), testCase.expected; got != want {
+			t.Errorf("NormalizeTableItemNumber(%q) = %q, want %q", testCase.input, got, want)
+		}
+	}
+}
+
+func TestTableItemHash(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{`{"pk":{"S":"a"}}`, `{ "pk": { "S": "a" } }`, true},
+		{`{"pk":{"S":"a"},"v":{"N":"1.50"}}`, `{"v":{"N":"1.5"},"pk":{"S":"a"}}`, true},
+		{`{"pk":{"S":"a"},"v":{"L":[{"N":"1E2"}]}}`, `{"pk":{"S":"a"},"v":{"L":[{"N":"100"}]}}`, true},
+		{`{"pk":{"S":"a"}}`, `{"pk":{"S":"b"}}`, false},
+		{`{"pk":{"S":"a"},"v":{"N":"1"}}`, `{"pk":{"S":"a"},"v":{"S":"1"}}`, false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := tfdynamodb.TableItemHash(testCase.a) == tfdynamodb.TableItemHash(testCase.b), testCase.expected; got != want {
+			t.Errorf("TableItemHash(%q) == TableItemHash(%q) = %t, want %t", testCase.a, testCase.b, got, want)
+		}
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 60),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "60"),
+					resource.TestCheckNoResourceAttr(resourceName, "range_key"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				Config:   testAccTableItemsConfig_reversed(rName, 60),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 30),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 10),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "10"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "items.*", `{"pk":{"S":"item-0"},"value":{"N":"100"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_rangeKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemsModifyItem(ctx, rName, "item-1"),
+					testAccCheckTableItemsDeleteItem(ctx, rName, "item-3"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 5),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_numberFormat(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_numberFormat(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "items.*", `{"pk":{"S":"item-0"},"value":{"N":"1.50"},"values":{"L":[{"N":"1E2"}]}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_keySchemaValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_base(rName),
+			},
+			{
+				Config:      testAccTableItemsConfig_keyType(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`key attribute "pk" is of type N, DynamoDB Table \(` + rName + `\) expects S`),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		return testAccForEachTableItem(rs, func(key map[string]*dynamodb.AttributeValue) error {
+			_, err := tfdynamodb.FindTableItem(ctx, conn, rs.Primary.Attributes["table_name"], key)
+
+			return err
+		})
+	}
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			err := testAccForEachTableItem(rs, func(key map[string]*dynamodb.AttributeValue) error {
+				_, err := tfdynamodb.FindTableItem(ctx, conn, rs.Primary.Attributes["table_name"], key)
+
+				if tfresource.NotFound(err) {
+					return nil
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("DynamoDB Table (%s) Item %v still exists", rs.Primary.ID, key)
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccForEachTableItem(rs *terraform.ResourceState, f func(map[string]*dynamodb.AttributeValue) error) error {
+	for k, v := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "items.") || k == "items.#" {
+			continue
+		}
+
+		attributes, err := tfdynamodb.ExpandTableItemAttributes(v)
+		if err != nil {
+			return err
+		}
+
+		if err := f(tfdynamodb.BuildTableItemQueryKey(attributes, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTableItemsModifyItem(ctx context.Context, tableName, pk string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		_, err := conn.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+			Item: map[string]*dynamodb.AttributeValue{
+				"pk":    {S: aws.String(pk)},
+				"value": {N: aws.String("-1")},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckTableItemsDeleteItem(ctx context.Context, tableName, pk string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		_, err := conn.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+			Key: map[string]*dynamodb.AttributeValue{
+				"pk": {S: aws.String(pk)},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccTableItemsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [for i in range(%[1]d) : jsonencode({
+    pk    = { S = "item-${i}" }
+    value = { N = tostring(i) }
+    tags  = { SS = ["a", "b"] }
+  })]
+}
+`, count))
+}
+
+func testAccTableItemsConfig_reversed(rName string, count int) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = reverse([for i in range(%[1]d) : jsonencode({
+    pk    = { S = "item-${i}" }
+    value = { N = tostring(i) }
+    tags  = { SS = ["a", "b"] }
+  })])
+}
+`, count))
+}
+
+func testAccTableItemsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [
+    jsonencode({
+      pk    = { S = "item-0" }
+      value = { N = "100" }
+    }),
+    jsonencode({
+      pk    = { S = "item-new" }
+      value = { N = "200" }
+    }),
+  ]
+}
+`)
+}
+
+func testAccTableItemsConfig_numberFormat(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [
+    jsonencode({
+      pk     = { S = "item-0" }
+      value  = { N = "1.50" }
+      values = { L = [{ N = "1E2" }] }
+    }),
+  ]
+}
+`)
+}
+
+func testAccTableItemsConfig_rangeKey(rName string) string {
+	var items []string
+	for i := 0; i < 3; i++ {
+		items = append(items, fmt.Sprintf(`    jsonencode({
+      pk = { S = "item" }
+      sk = { N = "%[1]d" }
+    }),`, i))
+	}
+
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "pk"
+  range_key      = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = [
+%[2]s
+  ]
+}
+`, rName, strings.Join(items, "\n"))
+}
+
+func testAccTableItemsConfig_keyType(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = "pk"
+
+  items = [
+    jsonencode({
+      pk = { N = "1" }
+    }),
+  ]
+}
+`)
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -64,6 +66,10 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.DynamoDB, importArn
 	}
 
 	if output, ok := outputRaw.(*dynamodb.DescribeImportOutput); ok {
+		if v := output.ImportTableDescription; v != nil && aws.StringValue(v.ImportStatus) == dynamodb.ImportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.FailureCode), aws.StringValue(v.FailureMessage)))
+		}
+
 		return output, err
 	}
 
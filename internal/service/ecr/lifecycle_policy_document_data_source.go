@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	lifecyclePolicyActionTypeExpire = "expire"
+
+	lifecyclePolicyCountTypeImageCountMoreThan = "imageCountMoreThan"
+	lifecyclePolicyCountTypeSinceImagePushed   = "sinceImagePushed"
+
+	lifecyclePolicyCountUnitDays = "days"
+
+	lifecyclePolicyTagStatusAny      = "any"
+	lifecyclePolicyTagStatusTagged   = "tagged"
+	lifecyclePolicyTagStatusUntagged = "untagged"
+
+	// See https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html#lifecycle_policy_parameters.
+	lifecyclePolicyTagPatternMaxWildcards = 4
+)
+
+func lifecyclePolicyCountType_Values() []string {
+	return []string{
+		lifecyclePolicyCountTypeImageCountMoreThan,
+		lifecyclePolicyCountTypeSinceImagePushed,
+	}
+}
+
+func lifecyclePolicyTagStatus_Values() []string {
+	return []string{
+		lifecyclePolicyTagStatusAny,
+		lifecyclePolicyTagStatusTagged,
+		lifecyclePolicyTagStatusUntagged,
+	}
+}
+
+// @SDKDataSource("aws_ecr_lifecycle_policy_document", name="Lifecycle Policy Document")
+func dataSourceLifecyclePolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLifecyclePolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyActionTypeExpire}, false),
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"count_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyCountType_Values(), false),
+									},
+									"count_unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyCountUnitDays}, false),
+									},
+									"tag_pattern_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+									"tag_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      lifecyclePolicyTagStatusAny,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyTagStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type lifecyclePolicyDocument struct {
+	Rules []*lifecyclePolicyDocumentRule `json:"rules"`
+}
+
+type lifecyclePolicyDocumentRule struct {
+	RulePriority int                                   `json:"rulePriority"`
+	Description  string                                `json:"description,omitempty"`
+	Selection    *lifecyclePolicyDocumentRuleSelection `json:"selection"`
+	Action       *lifecyclePolicyDocumentRuleAction    `json:"action"`
+}
+
+type lifecyclePolicyDocumentRuleSelection struct {
+	TagStatus      string   `json:"tagStatus"`
+	TagPatternList []string `json:"tagPatternList,omitempty"`
+	TagPrefixList  []string `json:"tagPrefixList,omitempty"`
+	CountType      string   `json:"countType"`
+	CountUnit      string   `json:"countUnit,omitempty"`
+	CountNumber    int      `json:"countNumber"`
+}
+
+type lifecyclePolicyDocumentRuleAction struct {
+	Type string `json:"type"`
+}
+
+func dataSourceLifecyclePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := expandLifecyclePolicyDocument(d.Get("rule").([]interface{}))
+
+	if err := doc.validate(); err != nil {
+		return sdkdiag.AppendErrorf(diags, "invalid ECR Lifecycle Policy Document: %s", err)
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing ECR Lifecycle Policy Document: formatting JSON: %s", err)
+	}
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+func expandLifecyclePolicyDocument(tfList []interface{}) *lifecyclePolicyDocument {
+	doc := &lifecyclePolicyDocument{
+		Rules: []*lifecyclePolicyDocumentRule{},
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := &lifecyclePolicyDocumentRule{
+			RulePriority: tfMap["priority"].(int),
+			Description:  tfMap["description"].(string),
+			Selection:    &lifecyclePolicyDocumentRuleSelection{},
+			Action: &lifecyclePolicyDocumentRuleAction{
+				Type: lifecyclePolicyActionTypeExpire,
+			},
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			rule.Action.Type = v[0].(map[string]interface{})["type"].(string)
+		}
+
+		if v, ok := tfMap["selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			rule.Selection = &lifecyclePolicyDocumentRuleSelection{
+				CountNumber:    tfMap["count_number"].(int),
+				CountType:      tfMap["count_type"].(string),
+				CountUnit:      tfMap["count_unit"].(string),
+				TagPatternList: flex.ExpandStringValueList(tfMap["tag_pattern_list"].([]interface{})),
+				TagPrefixList:  flex.ExpandStringValueList(tfMap["tag_prefix_list"].([]interface{})),
+				TagStatus:      tfMap["tag_status"].(string),
+			}
+		}
+
+		doc.Rules = append(doc.Rules, rule)
+	}
+
+	sort.SliceStable(doc.Rules, func(i, j int) bool {
+		return doc.Rules[i].RulePriority < doc.Rules[j].RulePriority
+	})
+
+	return doc
+}
+
+// validate checks the document against the lifecycle policy rules documented at
+// https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html#lp_evaluation_rules.
+// Rules are expected to be sorted by priority.
+func (doc *lifecyclePolicyDocument) validate() error {
+	priorities := make(map[int]struct{}, len(doc.Rules))
+
+	for i, rule := range doc.Rules {
+		if _, ok := priorities[rule.RulePriority]; ok {
+			return fmt.Errorf("rule priority %d is not unique", rule.RulePriority)
+		}
+		priorities[rule.RulePriority] = struct{}{}
+
+		if err := rule.Selection.validate(); err != nil {
+			return fmt.Errorf("rule (priority %d): %w", rule.RulePriority, err)
+		}
+
+		if rule.Selection.TagStatus == lifecyclePolicyTagStatusAny && i != len(doc.Rules)-1 {
+			return fmt.Errorf("rule (priority %d): a rule with tag_status %q must have the highest priority value", rule.RulePriority, lifecyclePolicyTagStatusAny)
+		}
+	}
+
+	return nil
+}
+
+func (selection *lifecyclePolicyDocumentRuleSelection) validate() error {
+	hasPatterns, hasPrefixes := len(selection.TagPatternList) > 0, len(selection.TagPrefixList) > 0
+
+	switch selection.TagStatus {
+	case lifecyclePolicyTagStatusTagged:
+		if hasPatterns == hasPrefixes {
+			return fmt.Errorf("exactly one of tag_pattern_list or tag_prefix_list must be set when tag_status is %q", selection.TagStatus)
+		}
+	default:
+		if hasPatterns || hasPrefixes {
+			return fmt.Errorf("tag_pattern_list and tag_prefix_list can only be set when tag_status is %q", lifecyclePolicyTagStatusTagged)
+		}
+	}
+
+	for _, v := range selection.TagPatternList {
+		if n := strings.Count(v, "*"); n > lifecyclePolicyTagPatternMaxWildcards {
+			return fmt.Errorf("tag pattern %q has %d wildcards, at most %d are allowed", v, n, lifecyclePolicyTagPatternMaxWildcards)
+		}
+	}
+
+	switch selection.CountType {
+	case lifecyclePolicyCountTypeImageCountMoreThan:
+		if selection.CountUnit != "" {
+			return fmt.Errorf("count_unit must not be set when count_type is %q", selection.CountType)
+		}
+	case lifecyclePolicyCountTypeSinceImagePushed:
+		if selection.CountUnit == "" {
+			return fmt.Errorf("count_unit must be set when count_type is %q", selection.CountType)
+		}
+	}
+
+	return nil
+}
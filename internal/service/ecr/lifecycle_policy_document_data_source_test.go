@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRLifecyclePolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecr_lifecycle_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccLifecyclePolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_lifecyclePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyDocumentDataSourceConfig_lifecyclePolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policy", testAccLifecyclePolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_duplicatePriority,
+				ExpectError: regexache.MustCompile(`rule priority 1 is not unique`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_taggedWithoutPrefixes,
+				ExpectError: regexache.MustCompile(`exactly one of tag_pattern_list or tag_prefix_list must be set when tag_status is "tagged"`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_untaggedWithPrefixes,
+				ExpectError: regexache.MustCompile(`tag_pattern_list and tag_prefix_list can only be set when tag_status is "tagged"`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_sinceImagePushedWithoutUnit,
+				ExpectError: regexache.MustCompile(`count_unit must be set when count_type is "sinceImagePushed"`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_imageCountMoreThanWithUnit,
+				ExpectError: regexache.MustCompile(`count_unit must not be set when count_type is "imageCountMoreThan"`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_anyNotLast,
+				ExpectError: regexache.MustCompile(`a rule with tag_status "any" must have the highest priority value`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_tooManyWildcards,
+				ExpectError: regexache.MustCompile(`tag pattern "\*a\*b\*c\*d\*" has 5 wildcards, at most 4 are allowed`),
+			},
+		},
+	})
+}
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_basic = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 3
+    selection {
+      count_type   = "imageCountMoreThan"
+      count_number = 100
+    }
+  }
+
+  rule {
+    priority    = 1
+    description = "Expire untagged images older than 14 days"
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_unit   = "days"
+      count_number = 14
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+
+  rule {
+    priority    = 2
+    description = "Keep the last 30 release images"
+
+    selection {
+      tag_status       = "tagged"
+      tag_pattern_list = ["release-*", "v*.*"]
+      count_type       = "imageCountMoreThan"
+      count_number     = 30
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceExpectedJSON_basic = `{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "description": "Expire untagged images older than 14 days",
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 14
+      },
+      "action": {
+        "type": "expire"
+      }
+    },
+    {
+      "rulePriority": 2,
+      "description": "Keep the last 30 release images",
+      "selection": {
+        "tagStatus": "tagged",
+        "tagPatternList": ["release-*", "v*.*"],
+        "countType": "imageCountMoreThan",
+        "countNumber": 30
+      },
+      "action": {
+        "type": "expire"
+      }
+    },
+    {
+      "rulePriority": 3,
+      "selection": {
+        "tagStatus": "any",
+        "countType": "imageCountMoreThan",
+        "countNumber": 100
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}`
+
+func testAccLifecyclePolicyDocumentDataSourceConfig_lifecyclePolicy(rName string) string {
+	return acctest.ConfigCompose(testAccLifecyclePolicyDocumentDataSourceConfig_basic, fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+  policy     = data.aws_ecr_lifecycle_policy_document.test.json
+}
+`, rName))
+}
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_duplicatePriority = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 1
+    selection {
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_taggedWithoutPrefixes = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+    selection {
+      tag_status   = "tagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_untaggedWithPrefixes = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+    selection {
+      tag_status      = "untagged"
+      tag_prefix_list = ["prod"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_sinceImagePushedWithoutUnit = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+    selection {
+      count_type   = "sinceImagePushed"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_imageCountMoreThanWithUnit = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+    selection {
+      count_type   = "imageCountMoreThan"
+      count_unit   = "days"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_anyNotLast = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 2
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_tooManyWildcards = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+    selection {
+      tag_status       = "tagged"
+      tag_pattern_list = ["*a*b*c*d*"]
+      count_type       = "imageCountMoreThan"
+      count_number     = 1
+    }
+  }
+}
+`
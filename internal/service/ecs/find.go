@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -157,3 +158,97 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+// findStoppedTasksByServiceDeploymentID returns the stopped tasks started by an ECS Service deployment.
+// Stopped tasks are only retained by ECS for a short time.
+func findStoppedTasksByServiceDeploymentID(ctx context.Context, conn *ecs.ECS, cluster, deploymentID string) ([]*ecs.Task, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		StartedBy:     aws.String(deploymentID),
+	}
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	var taskARNs []*string
+
+	err := conn.ListTasksPagesWithContext(ctx, input, func(page *ecs.ListTasksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		taskARNs = append(taskARNs, page.TaskArns...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*ecs.Task
+
+	const (
+		describeTasksMaxItems = 100
+	)
+	for _, chunk := range tfslices.Chunks(taskARNs, describeTasksMaxItems) {
+		input := &ecs.DescribeTasksInput{
+			Cluster: input.Cluster,
+			Tasks:   chunk,
+		}
+
+		page, err := conn.DescribeTasksWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Tasks...)
+	}
+
+	return output, nil
+}
+
+// findTaskDefinitionARNsByFamily returns the ARNs of a task definition family's revisions with the given status, newest first.
+func findTaskDefinitionARNsByFamily(ctx context.Context, conn *ecs.ECS, family, status string) ([]string, error) {
+	input := &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+		Sort:         aws.String(ecs.SortOrderDesc),
+		Status:       aws.String(status),
+	}
+
+	output, err := findTaskDefinitionARNs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return filterTaskDefinitionARNsByFamily(output, family), nil
+}
+
+// filterTaskDefinitionARNsByFamily removes the ARNs of other families matched by a ListTaskDefinitions family prefix.
+func filterTaskDefinitionARNsByFamily(arns []string, family string) []string {
+	return tfslices.Filter(arns, func(v string) bool {
+		return taskDefinitionFamilyFromARN(v) == family
+	})
+}
+
+func findTaskDefinitionARNs(ctx context.Context, conn *ecs.ECS, input *ecs.ListTaskDefinitionsInput) ([]string, error) {
+	var output []string
+
+	err := conn.ListTaskDefinitionsPagesWithContext(ctx, input, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.TaskDefinitionArns)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -125,6 +125,10 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"deployment_controller": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -164,6 +168,10 @@ func ResourceService() *schema.Resource {
 					return false
 				},
 			},
+			"deployment_rollout_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"desired_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -181,6 +189,11 @@ func ResourceService() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"fail_on_alarm_rollback": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"force_new_deployment": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -524,6 +537,7 @@ func ResourceService() *schema.Resource {
 			verify.SetTagsDiff,
 			capacityProviderStrategyCustomizeDiff,
 			triggersCustomizeDiff,
+			deploymentCustomizeDiff,
 		),
 	}
 }
@@ -666,15 +680,18 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	d.SetId(aws.StringValue(output.Service.ServiceArn))
+	setServiceDeployment(d, primaryServiceDeployment(output.Service))
 
-	fn := waitServiceActive
 	if d.Get("wait_for_steady_state").(bool) {
-		fn = waitServiceStable
-	}
-	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = append(diags, waitServiceSteadyState(ctx, conn, d, output.Service, d.Timeout(schema.TimeoutCreate))...)
+	} else if _, err := waitServiceActive(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 	}
 
+	if diags.HasError() {
+		return diags
+	}
+
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
 		err := createTags(ctx, conn, d.Id(), tags)
@@ -793,6 +810,13 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "setting deployment_controller: %s", err)
 	}
 
+	// Refresh the last deployment recorded by Terraform while ECS still reports it, otherwise keep its final state.
+	if deployment := serviceDeploymentByID(service, d.Get("deployment_id").(string)); deployment != nil {
+		setServiceDeployment(d, deployment)
+	} else if d.Get("deployment_id").(string) == "" {
+		setServiceDeployment(d, primaryServiceDeployment(service))
+	}
+
 	if service.LoadBalancers != nil {
 		if err := d.Set("load_balancer", flattenLoadBalancers(service.LoadBalancers)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting load_balancer: %s", err)
@@ -965,8 +989,10 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		// Retry due to IAM eventual consistency
+		var output *ecs.UpdateServiceOutput
 		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
-			_, err := conn.UpdateServiceWithContext(ctx, input)
+			var err error
+			output, err = conn.UpdateServiceWithContext(ctx, input)
 
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
@@ -983,20 +1009,24 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateServiceWithContext(ctx, input)
+			output, err = conn.UpdateServiceWithContext(ctx, input)
 		}
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", d.Id(), err)
 		}
 
-		fn := waitServiceActive
+		setServiceDeployment(d, primaryServiceDeployment(output.Service))
+
 		if d.Get("wait_for_steady_state").(bool) {
-			fn = waitServiceStable
-		}
-		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = append(diags, waitServiceSteadyState(ctx, conn, d, output.Service, d.Timeout(schema.TimeoutUpdate))...)
+		} else if _, err := waitServiceActive(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 		}
+
+		if diags.HasError() {
+			return diags
+		}
 	}
 
 	return append(diags, resourceServiceRead(ctx, d, meta)...)
@@ -1114,6 +1144,49 @@ func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 	return nil
 }
 
+// serviceDeploymentTriggerKeys are the attributes whose in-place update starts a new deployment.
+var serviceDeploymentTriggerKeys = []string{
+	"capacity_provider_strategy",
+	"enable_ecs_managed_tags",
+	"load_balancer",
+	"network_configuration",
+	"ordered_placement_strategy",
+	"placement_constraints",
+	"platform_version",
+	"propagate_tags",
+	"service_connect_configuration",
+	"service_registries",
+	"task_definition",
+}
+
+func deploymentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	newDeployment := d.HasChanges(serviceDeploymentTriggerKeys...)
+
+	// With force_new_deployment, any in-place update other than to tags starts a new deployment.
+	if !newDeployment && d.Get("force_new_deployment").(bool) {
+		for _, key := range d.GetChangedKeysPrefix("") {
+			if !strings.HasPrefix(key, names.AttrTags) {
+				newDeployment = true
+				break
+			}
+		}
+	}
+
+	if !newDeployment {
+		return nil
+	}
+
+	if err := d.SetNewComputed("deployment_id"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("deployment_rollout_state")
+}
+
 func capacityProviderStrategyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// to be backward compatible, should ForceNew almost always (previous behavior), unless:
 	//   force_new_deployment is true and
@@ -1618,6 +1691,156 @@ func resourceLoadBalancerHash(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
+// waitServiceSteadyState waits for the deployment started by creating or updating an ECS Service to complete and records it in state.
+// A failed deployment is an error unless it was rolled back by a CloudWatch alarm and fail_on_alarm_rollback is not set.
+func waitServiceSteadyState(ctx context.Context, conn *ecs.ECS, d *schema.ResourceData, service *ecs.Service, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	cluster := d.Get("cluster").(string)
+
+	deployment := primaryServiceDeployment(service)
+
+	// Services using the CODE_DEPLOY or EXTERNAL deployment controllers are deployed via task sets.
+	if deployment == nil {
+		if _, err := waitServiceStable(ctx, conn, d.Id(), cluster, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) to reach steady state: %s", d.Id(), err)
+		}
+
+		return diags
+	}
+
+	deploymentID := aws.StringValue(deployment.Id)
+	deployment, err := waitServiceDeploymentStable(ctx, conn, d.Id(), cluster, deploymentID, timeout)
+
+	if deployment != nil {
+		setServiceDeployment(d, deployment)
+	}
+
+	if err != nil {
+		diags = append(diags, serviceDeploymentStoppedTasksDiags(ctx, conn, cluster, deploymentID)...)
+		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) deployment (%s) to complete: %s", d.Id(), deploymentID, err)
+	}
+
+	if aws.StringValue(deployment.RolloutState) != ecs.DeploymentRolloutStateFailed {
+		return diags
+	}
+
+	diags = append(diags, serviceDeploymentStoppedTasksDiags(ctx, conn, cluster, deploymentID)...)
+	reason := aws.StringValue(deployment.RolloutStateReason)
+
+	if isServiceDeploymentAlarmRollback(service, deployment) && !d.Get("fail_on_alarm_rollback").(bool) {
+		return sdkdiag.AppendWarningf(diags, "ECS Service (%s) deployment (%s) rolled back: %s", d.Id(), deploymentID, reason)
+	}
+
+	return sdkdiag.AppendErrorf(diags, "ECS Service (%s) deployment (%s) failed: %s", d.Id(), deploymentID, reason)
+}
+
+// serviceDeploymentStoppedTasksDiags returns a warning for each distinct reason the deployment's tasks stopped.
+func serviceDeploymentStoppedTasksDiags(ctx context.Context, conn *ecs.ECS, cluster, deploymentID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tasks, err := findStoppedTasksByServiceDeploymentID(ctx, conn, cluster, deploymentID)
+
+	if err != nil {
+		log.Printf("[WARN] listing ECS Service deployment (%s) stopped tasks: %s", deploymentID, err)
+		return diags
+	}
+
+	var reasons []string
+	counts := make(map[string]int)
+
+	for _, task := range tasks {
+		reason := serviceTaskStoppedReason(task)
+
+		if counts[reason] == 0 {
+			reasons = append(reasons, reason)
+		}
+		counts[reason]++
+	}
+
+	for _, reason := range reasons {
+		diags = sdkdiag.AppendWarningf(diags, "ECS Service deployment (%s): %d task(s) stopped: %s", deploymentID, counts[reason], reason)
+	}
+
+	return diags
+}
+
+func serviceTaskStoppedReason(task *ecs.Task) string {
+	reasons := []string{aws.StringValue(task.StoppedReason)}
+
+	for _, container := range task.Containers {
+		if v := aws.StringValue(container.Reason); v != "" {
+			reasons = append(reasons, fmt.Sprintf("container %s: %s", aws.StringValue(container.Name), v))
+		}
+
+		if v := aws.Int64Value(container.ExitCode); v != 0 {
+			reasons = append(reasons, fmt.Sprintf("container %s: exit code %d", aws.StringValue(container.Name), v))
+		}
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// isServiceDeploymentAlarmRollback returns whether a failed deployment was rolled back by CloudWatch alarms rather than the deployment circuit breaker.
+// The circuit breaker only trips once tasks have failed, so if both are enabled a deployment with failed tasks is attributed to the circuit breaker.
+func isServiceDeploymentAlarmRollback(service *ecs.Service, deployment *ecs.Deployment) bool {
+	if service == nil || service.DeploymentConfiguration == nil || deployment == nil {
+		return false
+	}
+
+	if aws.StringValue(deployment.RolloutState) != ecs.DeploymentRolloutStateFailed {
+		return false
+	}
+
+	alarms := service.DeploymentConfiguration.Alarms
+
+	if alarms == nil || !aws.BoolValue(alarms.Enable) || !aws.BoolValue(alarms.Rollback) {
+		return false
+	}
+
+	if circuitBreaker := service.DeploymentConfiguration.DeploymentCircuitBreaker; circuitBreaker != nil && aws.BoolValue(circuitBreaker.Enable) {
+		return aws.Int64Value(deployment.FailedTasks) == 0
+	}
+
+	return true
+}
+
+func primaryServiceDeployment(service *ecs.Service) *ecs.Deployment {
+	if service == nil {
+		return nil
+	}
+
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
+func serviceDeploymentByID(service *ecs.Service, id string) *ecs.Deployment {
+	if service == nil || id == "" {
+		return nil
+	}
+
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Id) == id {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
+func setServiceDeployment(d *schema.ResourceData, deployment *ecs.Deployment) {
+	if deployment == nil {
+		return
+	}
+
+	d.Set("deployment_id", deployment.Id)
+	d.Set("deployment_rollout_state", deployment.RolloutState)
+}
+
 func serviceCreateWithRetry(ctx context.Context, conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
 	var output *ecs.CreateServiceOutput
 	err := retry.RetryContext(ctx, propagationTimeout+serviceCreateTimeout, func() *retry.RetryError {
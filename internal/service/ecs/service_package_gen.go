@@ -43,6 +43,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTaskDefinition,
 			TypeName: "aws_ecs_task_definition",
 		},
+		{
+			Factory:  DataSourceTaskDefinitions,
+			TypeName: "aws_ecs_task_definitions",
+			Name:     "Task Definitions",
+		},
 		{
 			Factory:  DataSourceTaskExecution,
 			TypeName: "aws_ecs_task_execution",
@@ -26,6 +26,67 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestIsServiceDeploymentAlarmRollback(t *testing.T) {
+	t.Parallel()
+
+	alarms := &ecs.DeploymentAlarms{
+		AlarmNames: aws.StringSlice([]string{"test"}),
+		Enable:     aws.Bool(true),
+		Rollback:   aws.Bool(true),
+	}
+	circuitBreaker := &ecs.DeploymentCircuitBreaker{
+		Enable:   aws.Bool(true),
+		Rollback: aws.Bool(true),
+	}
+
+	testCases := map[string]struct {
+		deploymentConfiguration *ecs.DeploymentConfiguration
+		deployment              *ecs.Deployment
+		expected                bool
+	}{
+		"alarms": {
+			deploymentConfiguration: &ecs.DeploymentConfiguration{Alarms: alarms},
+			deployment:              &ecs.Deployment{RolloutState: aws.String(ecs.DeploymentRolloutStateFailed)},
+			expected:                true,
+		},
+		"alarms completed": {
+			deploymentConfiguration: &ecs.DeploymentConfiguration{Alarms: alarms},
+			deployment:              &ecs.Deployment{RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+		},
+		"alarms without rollback": {
+			deploymentConfiguration: &ecs.DeploymentConfiguration{Alarms: &ecs.DeploymentAlarms{Enable: aws.Bool(true), Rollback: aws.Bool(false)}},
+			deployment:              &ecs.Deployment{RolloutState: aws.String(ecs.DeploymentRolloutStateFailed)},
+		},
+		"circuit breaker": {
+			deploymentConfiguration: &ecs.DeploymentConfiguration{DeploymentCircuitBreaker: circuitBreaker},
+			deployment:              &ecs.Deployment{RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), FailedTasks: aws.Int64(3)},
+		},
+		"alarms and circuit breaker without failed tasks": {
+			deploymentConfiguration: &ecs.DeploymentConfiguration{Alarms: alarms, DeploymentCircuitBreaker: circuitBreaker},
+			deployment:              &ecs.Deployment{RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), FailedTasks: aws.Int64(0)},
+			expected:                true,
+		},
+		"alarms and circuit breaker with failed tasks": {
+			deploymentConfiguration: &ecs.DeploymentConfiguration{Alarms: alarms, DeploymentCircuitBreaker: circuitBreaker},
+			deployment:              &ecs.Deployment{RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), FailedTasks: aws.Int64(3)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service := &ecs.Service{DeploymentConfiguration: testCase.deploymentConfiguration}
+
+			if got, want := tfecs.IsServiceDeploymentAlarmRollback(service, testCase.deployment), testCase.expected; got != want {
+				t.Errorf("IsServiceDeploymentAlarmRollback() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func Test_GetRoleNameFromARN(t *testing.T) {
 	t.Parallel()
 
@@ -148,8 +209,9 @@ func TestAccECSService_basicImport(t *testing.T) {
 				ImportStateId:     importInput,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// wait_for_steady_state and fail_on_alarm_rollback are not read from API
+				// and deployment_rollout_state may have progressed since the deployment was recorded
+				ImportStateVerifyIgnore: []string{"deployment_rollout_state", "fail_on_alarm_rollback", "wait_for_steady_state"},
 			},
 			// Test non-existent resource import
 			{
@@ -433,8 +495,9 @@ func TestAccECSService_DeploymentControllerType_codeDeploy(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_steady_state and fail_on_alarm_rollback are not read from API
+				// and deployment_rollout_state may have progressed since the deployment was recorded
+				ImportStateVerifyIgnore: []string{"deployment_rollout_state", "fail_on_alarm_rollback", "task_definition", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -508,8 +571,9 @@ func TestAccECSService_DeploymentControllerType_external(t *testing.T) {
 				ImportStateId:     fmt.Sprintf("%s/%s", rName, rName),
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// wait_for_steady_state and fail_on_alarm_rollback are not read from API
+				// and deployment_rollout_state may have progressed since the deployment was recorded
+				ImportStateVerifyIgnore: []string{"deployment_rollout_state", "fail_on_alarm_rollback", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -1045,6 +1109,8 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 				Config: testAccServiceConfig_launchTypeFargateAndWait(rName, 1, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_rollout_state", "COMPLETED"),
 					resource.TestCheckResourceAttr(resourceName, "desired_count", "1"),
 					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state", "true"),
 				),
@@ -1055,8 +1121,26 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_steady_state and fail_on_alarm_rollback are not read from API
+				ImportStateVerifyIgnore: []string{"fail_on_alarm_rollback", "task_definition", "wait_for_steady_state"},
+			},
+		},
+	})
+}
+
+func TestAccECSService_LaunchTypeFargate_waitForSteadyStateCircuitBreakerFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceConfig_launchTypeFargateCircuitBreakerFailure(rName),
+				ExpectError: regexache.MustCompile(`deployment \(ecs-svc/\d+\) failed: .*circuit breaker`),
 			},
 		},
 	})
@@ -1460,8 +1544,9 @@ func TestAccECSService_Tags_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_steady_state and fail_on_alarm_rollback are not read from API
+				// and deployment_rollout_state may have progressed since the deployment was recorded
+				ImportStateVerifyIgnore: []string{"deployment_rollout_state", "fail_on_alarm_rollback", "task_definition", "wait_for_steady_state"},
 			},
 			{
 				Config: testAccServiceConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
@@ -1866,6 +1951,46 @@ resource "aws_ecs_service" "test" {
 `, rName, desiredCount, waitForSteadyState))
 }
 
+func testAccServiceConfig_launchTypeFargateCircuitBreakerFailure(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "failing" {
+  family                   = "%[1]s-failing"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    name      = "failing"
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    command   = ["sh", "-c", "exit 1"]
+    essential = true
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.failing.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = false
+  }
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  wait_for_steady_state = true
+}
+`, rName))
+}
+
 func testAccServiceConfig_interchangeablePlacementStrategy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
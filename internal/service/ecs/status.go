@@ -20,6 +20,8 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
@@ -96,6 +98,37 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 	}
 }
 
+// statusServiceDeployment returns the rollout state of an ECS Service deployment.
+// A completed deployment is only reported once it is the service's sole deployment and all of its tasks are running.
+func statusServiceDeployment(ctx context.Context, conn *ecs.ECS, id, cluster, deploymentID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := FindServiceNoTagsByID(ctx, conn, id, cluster)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		deployment := serviceDeploymentByID(service, deploymentID)
+		if deployment == nil {
+			return nil, "", nil
+		}
+
+		switch state := aws.StringValue(deployment.RolloutState); state {
+		// The rollout state is not tracked for deployments behind a Classic Load Balancer.
+		case ecs.DeploymentRolloutStateCompleted, "":
+			if len(service.Deployments) == 1 && aws.Int64Value(deployment.DesiredCount) == aws.Int64Value(deployment.RunningCount) {
+				return deployment, ecs.DeploymentRolloutStateCompleted, nil
+			}
+
+			return deployment, ecs.DeploymentRolloutStateInProgress, nil
+		default:
+			return deployment, state, nil
+		}
+	}
+}
+
 func stabilityStatusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &ecs.DescribeTaskSetsInput{
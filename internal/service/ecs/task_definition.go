@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -103,6 +105,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"delete_old_revisions": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"keep_revisions"},
+			},
 			"ephemeral_storage": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -159,6 +166,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ecs.IpcMode_Values(), false),
 			},
+			"keep_revisions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"memory": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -562,6 +574,12 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if v, ok := d.GetOk("keep_revisions"); ok {
+		if err := pruneTaskDefinitionRevisions(ctx, conn, d.Get("arn").(string), v.(int), d.Get("delete_old_revisions").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning ECS Task Definition (%s) revisions: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceTaskDefinitionRead(ctx, d, meta)...)
 }
 
@@ -670,8 +688,14 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 
 func resourceTaskDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
-	// Tags only.
+	// Tags are updated transparently, all other arguments except revision retention force a new resource.
+	if v, ok := d.GetOk("keep_revisions"); ok && d.HasChanges("keep_revisions", "delete_old_revisions") {
+		if err := pruneTaskDefinitionRevisions(ctx, conn, d.Get("arn").(string), v.(int), d.Get("delete_old_revisions").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning ECS Task Definition (%s) revisions: %s", d.Id(), err)
+		}
+	}
 
 	return append(diags, resourceTaskDefinitionRead(ctx, d, meta)...)
 }
@@ -695,6 +719,82 @@ func resourceTaskDefinitionDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// pruneTaskDefinitionRevisions deregisters all but the newest keep ACTIVE revisions of a task definition's family.
+// The task definition itself is always retained. If deleteInactive is set, the revisions deregistered here are then deleted;
+// revisions that were already INACTIVE are left untouched.
+func pruneTaskDefinitionRevisions(ctx context.Context, conn *ecs.ECS, taskDefinitionARN string, keep int, deleteInactive bool) error {
+	family := taskDefinitionFamilyFromARN(taskDefinitionARN)
+	if family == "" {
+		return fmt.Errorf("parsing family from ARN (%s)", taskDefinitionARN)
+	}
+
+	arns, err := findTaskDefinitionARNsByFamily(ctx, conn, family, ecs.TaskDefinitionStatusActive)
+
+	if err != nil {
+		return fmt.Errorf("listing ACTIVE revisions: %w", err)
+	}
+
+	var deregistered []string
+	// Revisions are listed newest first.
+	for i, arn := range arns {
+		if i < keep || arn == taskDefinitionARN {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deregistering ECS Task Definition revision: %s", arn)
+		_, err := conn.DeregisterTaskDefinitionWithContext(ctx, &ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: aws.String(arn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deregistering revision (%s): %w", arn, err)
+		}
+
+		deregistered = append(deregistered, arn)
+	}
+
+	if !deleteInactive {
+		return nil
+	}
+
+	const (
+		deleteTaskDefinitionsMaxItems = 10
+	)
+	for _, chunk := range tfslices.Chunks(deregistered, deleteTaskDefinitionsMaxItems) {
+		log.Printf("[DEBUG] Deleting ECS Task Definition revisions: %s", chunk)
+		output, err := conn.DeleteTaskDefinitionsWithContext(ctx, &ecs.DeleteTaskDefinitionsInput{
+			TaskDefinitions: aws.StringSlice(chunk),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting revisions: %w", err)
+		}
+
+		var failures []error
+		for _, v := range output.Failures {
+			failures = append(failures, fmt.Errorf("deleting revision (%s): %s: %s", aws.StringValue(v.Arn), aws.StringValue(v.Reason), aws.StringValue(v.Detail)))
+		}
+
+		if err := errors.Join(failures...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// taskDefinitionFamilyFromARN returns the family of a task definition ARN with or without revision.
+func taskDefinitionFamilyFromARN(s string) string {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return ""
+	}
+
+	family, _, _ := strings.Cut(strings.TrimPrefix(v.Resource, "task-definition/"), ":")
+
+	return family
+}
+
 func resourceTaskDefinitionVolumeHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -1164,6 +1164,78 @@ func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_keepRevisions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_keepRevisions(rName, "v1", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "keep_revisions", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delete_old_revisions", "false"),
+					testAccCheckTaskDefinitionRevisionCount(ctx, rName, ecs.TaskDefinitionStatusActive, 1),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_keepRevisions(rName, "v2", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+					testAccCheckTaskDefinitionRevisionCount(ctx, rName, ecs.TaskDefinitionStatusActive, 1),
+					testAccCheckTaskDefinitionRevisionCount(ctx, rName, ecs.TaskDefinitionStatusInactive, 1),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_keepRevisions(rName, "v3", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "3"),
+					resource.TestCheckResourceAttr(resourceName, "delete_old_revisions", "true"),
+					testAccCheckTaskDefinitionRevisionCount(ctx, rName, ecs.TaskDefinitionStatusActive, 1),
+					// Only revision 2, deregistered by this apply, is deleted.
+					testAccCheckTaskDefinitionRevisionCount(ctx, rName, ecs.TaskDefinitionStatusInactive, 1),
+				),
+			},
+			{
+				// Disable skip_destroy so that the final revision is deregistered on destroy.
+				Config: testAccTaskDefinitionConfig_keepRevisions(rName, "v3", false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "3"),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTaskDefinitionRevisionCount(ctx context.Context, family, status string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
+
+		arns, err := tfecs.FindTaskDefinitionARNsByFamily(ctx, conn, family, status)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(arns); got != want {
+			return fmt.Errorf("ECS Task Definition family (%s) has %d %s revisions, want %d", family, got, status, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccTaskDefinitionConfig_proxyConfiguration(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -2949,3 +3021,27 @@ TASK_DEFINITION
 }
 `, rName)
 }
+
+func testAccTaskDefinitionConfig_keepRevisions(rName, version string, skipDestroy, deleteOldRevisions bool) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([{
+    name      = "app"
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    cpu       = 10
+    memory    = 128
+    essential = true
+    environment = [{
+      name  = "VERSION"
+      value = %[2]q
+    }]
+  }])
+
+  keep_revisions       = 1
+  delete_old_revisions = %[4]t
+  skip_destroy         = %[3]t
+}
+`, rName, version, skipDestroy, deleteOldRevisions)
+}
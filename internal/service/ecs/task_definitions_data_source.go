@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_ecs_task_definitions", name="Task Definitions")
+func DataSourceTaskDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTaskDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sort": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ecs.SortOrderAsc,
+				ValidateFunc: validation.StringInSlice(ecs.SortOrder_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ecs.TaskDefinitionStatusActive,
+				ValidateFunc: validation.StringInSlice(ecs.TaskDefinitionStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceTaskDefinitionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	input := &ecs.ListTaskDefinitionsInput{
+		Sort:   aws.String(d.Get("sort").(string)),
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("family"); ok {
+		input.FamilyPrefix = aws.String(v.(string))
+	}
+
+	arns, err := findTaskDefinitionARNs(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definitions: %s", err)
+	}
+
+	if v, ok := d.GetOk("family"); ok {
+		arns = filterTaskDefinitionARNsByFamily(arns, v.(string))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+
+	return diags
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSTaskDefinitionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	familyDataSourceName := "data.aws_ecs_task_definitions.family"
+	inactiveDataSourceName := "data.aws_ecs_task_definitions.inactive"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(familyDataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(familyDataSourceName, "arns.0", "aws_ecs_task_definition.test", "arn"),
+					resource.TestCheckResourceAttr(inactiveDataSourceName, "arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaskDefinitionsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([{
+    name      = "app"
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    cpu       = 10
+    memory    = 128
+    essential = true
+  }])
+}
+
+resource "aws_ecs_task_definition" "other" {
+  family = "%[1]s-other"
+
+  container_definitions = aws_ecs_task_definition.test.container_definitions
+}
+
+data "aws_ecs_task_definitions" "family" {
+  family = %[1]q
+
+  depends_on = [aws_ecs_task_definition.test, aws_ecs_task_definition.other]
+}
+
+data "aws_ecs_task_definitions" "inactive" {
+  family = %[1]q
+  status = "INACTIVE"
+
+  depends_on = [aws_ecs_task_definition.test]
+}
+`, rName)
+}
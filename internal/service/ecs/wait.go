@@ -5,11 +5,14 @@ package ecs
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -91,6 +94,32 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 	return nil, err
 }
 
+// waitServiceDeploymentStable waits for an ECS Service deployment to either complete or fail. Does not return tags.
+func waitServiceDeploymentStable(ctx context.Context, conn *ecs.ECS, id, cluster, deploymentID string, timeout time.Duration) (*ecs.Deployment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ecs.DeploymentRolloutStateInProgress},
+		Target:  []string{ecs.DeploymentRolloutStateCompleted, ecs.DeploymentRolloutStateFailed},
+		Refresh: statusServiceDeployment(ctx, conn, id, cluster, deploymentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ecs.Deployment); ok {
+		if tfresource.TimedOut(err) {
+			lastErr := fmt.Errorf("%d of %d tasks running, %d failed", aws.Int64Value(output.RunningCount), aws.Int64Value(output.DesiredCount), aws.Int64Value(output.FailedTasks))
+			if reason := aws.StringValue(output.RolloutStateReason); reason != "" {
+				lastErr = errors.Join(lastErr, errors.New(reason))
+			}
+			tfresource.SetLastError(err, lastErr)
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 // waitServiceInactive waits for an ECS Service to reach the status "INACTIVE".
 func waitServiceInactive(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
 	input := &ecs.DescribeServicesInput{
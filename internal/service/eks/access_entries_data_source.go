@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_eks_access_entries", name="Access Entries")
+func dataSourceAccessEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccessEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_entry_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_scope": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"namespaces": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"associated_at": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"modified_at": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"policy_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kubernetes_groups": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"modified_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+		},
+	}
+}
+
+func dataSourceAccessEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	accessEntries, err := findAccessEntriesByClusterName(ctx, conn, clusterName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Access Entries (%s): %s", clusterName, err)
+	}
+
+	var tfList []interface{}
+
+	for _, accessEntry := range accessEntries {
+		principalARN := aws.ToString(accessEntry.PrincipalArn)
+		associatedAccessPolicies, err := findAssociatedAccessPoliciesByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Access Entry (%s) access policies: %s", accessEntryCreateResourceID(clusterName, principalARN), err)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"access_entry_arn":  aws.ToString(accessEntry.AccessEntryArn),
+			"access_policy":     flattenAssociatedAccessPolicies(associatedAccessPolicies),
+			"created_at":        aws.ToTime(accessEntry.CreatedAt).Format(time.RFC3339),
+			"kubernetes_groups": accessEntry.KubernetesGroups,
+			"modified_at":       aws.ToTime(accessEntry.ModifiedAt).Format(time.RFC3339),
+			"principal_arn":     principalARN,
+			"type":              aws.ToString(accessEntry.Type),
+			"user_name":         aws.ToString(accessEntry.Username),
+		})
+	}
+
+	d.SetId(clusterName)
+	if err := d.Set("access_entries", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_entries: %s", err)
+	}
+	d.Set("cluster_name", clusterName)
+
+	return diags
+}
+
+func flattenAssociatedAccessPolicies(apiObjects []types.AssociatedAccessPolicy) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"access_scope":  flattenAccessScope(apiObject.AccessScope),
+			"associated_at": aws.ToTime(apiObject.AssociatedAt).Format(time.RFC3339),
+			"modified_at":   aws.ToTime(apiObject.ModifiedAt).Format(time.RFC3339),
+			"policy_arn":    aws.ToString(apiObject.PolicyArn),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAccessEntriesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_access_entries.test"
+	resourceName := "aws_eks_access_entry.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "cluster_name", "aws_eks_cluster.test", "name"),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceResourceName, "access_entries.#", 1),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceResourceName, "access_entries.*.access_entry_arn", resourceName, "access_entry_arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceResourceName, "access_entries.*", map[string]string{
+						"access_policy.#":                             "1",
+						"access_policy.0.access_scope.#":              "1",
+						"access_policy.0.access_scope.0.type":         "namespace",
+						"access_policy.0.access_scope.0.namespaces.#": "1",
+						"kubernetes_groups.#":                         "0",
+						"type":                                        "STANDARD",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessEntriesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_eks_access_entry" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_iam_user.test.arn
+}
+
+resource "aws_eks_access_policy_association" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_eks_access_entry.test.principal_arn
+  policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+  access_scope {
+    type       = "namespace"
+    namespaces = ["default"]
+  }
+}
+
+data "aws_eks_access_entries" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  depends_on = [aws_eks_access_policy_association.test]
+}
+`, rName))
+}
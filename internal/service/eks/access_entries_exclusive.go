@@ -0,0 +1,437 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_eks_access_entries_exclusive", name="Access Entries Exclusive")
+func resourceAccessEntriesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessEntriesExclusiveCreate,
+		ReadWithoutTimeout:   resourceAccessEntriesExclusiveRead,
+		UpdateWithoutTimeout: resourceAccessEntriesExclusiveUpdate,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_entry": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_policy": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_scope": {
+										Type:     schema.TypeList,
+										MinItems: 1,
+										MaxItems: 1,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"namespaces": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"type": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.AccessScopeType](),
+												},
+											},
+										},
+									},
+									"policy_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"kubernetes_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"principal_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      accessEntryTypeStandard,
+							ValidateFunc: validation.StringInSlice(accessEntryType_Values(), false),
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"ignore_principal_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceAccessEntriesExclusiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+
+	if err := syncAccessEntries(ctx, conn, clusterName, d.Get("access_entry").(*schema.Set).List(), flex.ExpandStringValueSet(d.Get("ignore_principal_arns").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EKS Access Entries Exclusive (%s): %s", clusterName, err)
+	}
+
+	d.SetId(clusterName)
+
+	return append(diags, resourceAccessEntriesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceAccessEntriesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Id()
+	accessEntries, err := findAccessEntriesByClusterName(ctx, conn, clusterName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Access Entries Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Access Entries Exclusive (%s): %s", d.Id(), err)
+	}
+
+	ignorePrincipalARNs := flex.ExpandStringValueSet(d.Get("ignore_principal_arns").(*schema.Set))
+	userNames := make(map[string]string)
+	for _, tfMapRaw := range d.Get("access_entry").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		userNames[tfMap["principal_arn"].(string)] = tfMap["user_name"].(string)
+	}
+
+	var tfList []interface{}
+
+	for _, accessEntry := range accessEntries {
+		principalARN := aws.ToString(accessEntry.PrincipalArn)
+
+		if !isAccessEntryExclusivelyManaged(principalARN, ignorePrincipalARNs) {
+			continue
+		}
+
+		associatedAccessPolicies, err := findAssociatedAccessPoliciesByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Access Entries Exclusive (%s) access policies (%s): %s", d.Id(), principalARN, err)
+		}
+
+		tfMap := map[string]interface{}{
+			"access_policy": flattenAccessEntriesExclusiveAccessPolicies(associatedAccessPolicies),
+			"principal_arn": principalARN,
+			"type":          aws.ToString(accessEntry.Type),
+		}
+
+		// EKS adds its own Kubernetes groups to access entries that aren't of type STANDARD.
+		if aws.ToString(accessEntry.Type) == accessEntryTypeStandard {
+			tfMap["kubernetes_groups"] = accessEntry.KubernetesGroups
+		}
+
+		// EKS generates a user name when none is specified.
+		if userNames[principalARN] != "" {
+			tfMap["user_name"] = aws.ToString(accessEntry.Username)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if err := d.Set("access_entry", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_entry: %s", err)
+	}
+	d.Set("cluster_name", clusterName)
+
+	return diags
+}
+
+func resourceAccessEntriesExclusiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	if err := syncAccessEntries(ctx, conn, d.Id(), d.Get("access_entry").(*schema.Set).List(), flex.ExpandStringValueSet(d.Get("ignore_principal_arns").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EKS Access Entries Exclusive (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAccessEntriesExclusiveRead(ctx, d, meta)...)
+}
+
+// isAccessEntryExclusivelyManaged returns whether the access entry of the specified principal is owned by an aws_eks_access_entries_exclusive resource.
+func isAccessEntryExclusivelyManaged(principalARN string, ignorePrincipalARNs []string) bool {
+	return !isServiceLinkedRolePrincipal(principalARN) && !slices.Contains(ignorePrincipalARNs, principalARN)
+}
+
+// syncAccessEntries makes a cluster's access entries and their associated access policies match the configured ones.
+// Access entries that aren't configured are deleted, unless they are ignored.
+func syncAccessEntries(ctx context.Context, conn *eks.Client, clusterName string, tfList []interface{}, ignorePrincipalARNs []string) error {
+	principalARNs, err := findAccessEntryPrincipalARNsByClusterName(ctx, conn, clusterName)
+
+	if err != nil {
+		return fmt.Errorf("listing access entries: %w", err)
+	}
+
+	configured := make(map[string]struct{})
+	for _, tfMapRaw := range tfList {
+		configured[tfMapRaw.(map[string]interface{})["principal_arn"].(string)] = struct{}{}
+	}
+
+	for _, principalARN := range principalARNs {
+		if _, ok := configured[principalARN]; ok || !isAccessEntryExclusivelyManaged(principalARN, ignorePrincipalARNs) {
+			continue
+		}
+
+		if err := deleteAccessEntry(ctx, conn, clusterName, principalARN); err != nil {
+			return fmt.Errorf("deleting access entry (%s): %w", principalARN, err)
+		}
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if err := syncAccessEntry(ctx, conn, clusterName, tfMap, slices.Contains(principalARNs, tfMap["principal_arn"].(string))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func syncAccessEntry(ctx context.Context, conn *eks.Client, clusterName string, tfMap map[string]interface{}, exists bool) error {
+	principalARN := tfMap["principal_arn"].(string)
+	accessEntryType := tfMap["type"].(string)
+	kubernetesGroups := flex.ExpandStringValueSet(tfMap["kubernetes_groups"].(*schema.Set))
+	userName := tfMap["user_name"].(string)
+
+	var accessEntry *types.AccessEntry
+
+	if exists {
+		output, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return fmt.Errorf("reading access entry (%s): %w", principalARN, err)
+		case aws.ToString(output.Type) != accessEntryType:
+			// An access entry's type cannot be changed.
+			if err := deleteAccessEntry(ctx, conn, clusterName, principalARN); err != nil {
+				return fmt.Errorf("deleting access entry (%s): %w", principalARN, err)
+			}
+		default:
+			accessEntry = output
+		}
+	}
+
+	if accessEntry == nil {
+		input := &eks.CreateAccessEntryInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(principalARN),
+			Type:         aws.String(accessEntryType),
+		}
+
+		if len(kubernetesGroups) > 0 {
+			input.KubernetesGroups = kubernetesGroups
+		}
+
+		if userName != "" {
+			input.Username = aws.String(userName)
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.CreateAccessEntry(ctx, input)
+		}, "The specified principalArn is invalid: invalid principal")
+
+		if err != nil {
+			return fmt.Errorf("creating access entry (%s): %w", principalARN, err)
+		}
+	} else {
+		input := &eks.UpdateAccessEntryInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(principalARN),
+		}
+		update := false
+
+		if accessEntryType == accessEntryTypeStandard && !stringSlicesEqualIgnoreOrder(accessEntry.KubernetesGroups, kubernetesGroups) {
+			input.KubernetesGroups = kubernetesGroups
+			update = true
+		}
+
+		if userName != "" && userName != aws.ToString(accessEntry.Username) {
+			input.Username = aws.String(userName)
+			update = true
+		}
+
+		if update {
+			if _, err := conn.UpdateAccessEntry(ctx, input); err != nil {
+				return fmt.Errorf("updating access entry (%s): %w", principalARN, err)
+			}
+		}
+	}
+
+	associatedAccessPolicies, err := findAssociatedAccessPoliciesByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+	if err != nil {
+		return fmt.Errorf("listing access entry (%s) access policies: %w", principalARN, err)
+	}
+
+	configured := make(map[string]struct{})
+	for _, tfMapRaw := range tfMap["access_policy"].(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		policyARN := tfMap["policy_arn"].(string)
+		accessScope := expandAccessScope(tfMap["access_scope"].([]interface{}))
+		configured[policyARN] = struct{}{}
+
+		if i := slices.IndexFunc(associatedAccessPolicies, func(v types.AssociatedAccessPolicy) bool {
+			return aws.ToString(v.PolicyArn) == policyARN
+		}); i >= 0 && accessScopeEqual(associatedAccessPolicies[i].AccessScope, accessScope) {
+			continue
+		}
+
+		// Associating an already associated access policy replaces its access scope.
+		input := &eks.AssociateAccessPolicyInput{
+			AccessScope:  accessScope,
+			ClusterName:  aws.String(clusterName),
+			PolicyArn:    aws.String(policyARN),
+			PrincipalArn: aws.String(principalARN),
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.ResourceNotFoundException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.AssociateAccessPolicy(ctx, input)
+		}, "The specified principalArn could not be found")
+
+		if err != nil {
+			return fmt.Errorf("associating access entry (%s) access policy (%s): %w", principalARN, policyARN, err)
+		}
+	}
+
+	for _, v := range associatedAccessPolicies {
+		policyARN := aws.ToString(v.PolicyArn)
+
+		if _, ok := configured[policyARN]; ok {
+			continue
+		}
+
+		_, err := conn.DisassociateAccessPolicy(ctx, &eks.DisassociateAccessPolicyInput{
+			ClusterName:  aws.String(clusterName),
+			PolicyArn:    aws.String(policyARN),
+			PrincipalArn: aws.String(principalARN),
+		})
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating access entry (%s) access policy (%s): %w", principalARN, policyARN, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteAccessEntry(ctx context.Context, conn *eks.Client, clusterName, principalARN string) error {
+	_, err := conn.DeleteAccessEntry(ctx, &eks.DeleteAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func accessScopeEqual(a, b *types.AccessScope) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Type == b.Type && stringSlicesEqualIgnoreOrder(a.Namespaces, b.Namespaces)
+}
+
+func stringSlicesEqualIgnoreOrder(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
+
+func flattenAccessEntriesExclusiveAccessPolicies(apiObjects []types.AssociatedAccessPolicy) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"access_scope": flattenAccessScope(apiObject.AccessScope),
+			"policy_arn":   aws.ToString(apiObject.PolicyArn),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAccessEntriesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntryExistsForPrincipal(ctx, "aws_eks_cluster.test", "aws_iam_user.test.0"),
+					testAccCheckAccessEntryExistsForPrincipal(ctx, "aws_eks_cluster.test", "aws_iam_user.test.1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", "aws_eks_cluster.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_entry.*", map[string]string{
+						"access_policy.#":     "1",
+						"kubernetes_groups.#": "0",
+						"type":                "STANDARD",
+						"user_name":           "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_entry.*", map[string]string{
+						"access_policy.#":     "0",
+						"kubernetes_groups.#": "1",
+						"kubernetes_groups.0": "group1",
+						"type":                "STANDARD",
+						"user_name":           "user1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// user_name is only read when configured and ignore_principal_arns is not read from API.
+				ImportStateVerifyIgnore: []string{"access_entry", "ignore_principal_arns"},
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessEntriesExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntryExistsForPrincipal(ctx, "aws_eks_cluster.test", "aws_iam_user.test.1"),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "2"),
+				),
+			},
+			{
+				Config: testAccAccessEntriesExclusiveConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntryNotExistsForPrincipal(ctx, "aws_eks_cluster.test", "aws_iam_user.test.1"),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.0.access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.0.access_policy.0.access_scope.0.type", "namespace"),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.0.access_policy.0.access_scope.0.namespaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.0.kubernetes_groups.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessEntriesExclusive_unmanagedEntry(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntryCreateForPrincipal(ctx, "aws_eks_cluster.test", "aws_iam_user.unmanaged"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAccessEntriesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessEntryNotExistsForPrincipal(ctx, "aws_eks_cluster.test", "aws_iam_user.unmanaged"),
+					resource.TestCheckResourceAttr(resourceName, "access_entry.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessEntryExistsForPrincipal(ctx context.Context, clusterResourceName, principalResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clusterName, principalARN, err := testAccAccessEntryTwoPartKey(s, clusterResourceName, principalResourceName)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		_, err = tfeks.FindAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		return err
+	}
+}
+
+func testAccCheckAccessEntryNotExistsForPrincipal(ctx context.Context, clusterResourceName, principalResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clusterName, principalARN, err := testAccAccessEntryTwoPartKey(s, clusterResourceName, principalResourceName)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		_, err = tfeks.FindAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EKS Access Entry %s still exists", principalARN)
+	}
+}
+
+func testAccCheckAccessEntryCreateForPrincipal(ctx context.Context, clusterResourceName, principalResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clusterName, principalARN, err := testAccAccessEntryTwoPartKey(s, clusterResourceName, principalResourceName)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		_, err = conn.CreateAccessEntry(ctx, &eks.CreateAccessEntryInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(principalARN),
+		})
+
+		return err
+	}
+}
+
+func testAccAccessEntryTwoPartKey(s *terraform.State, clusterResourceName, principalResourceName string) (string, string, error) {
+	rsCluster, ok := s.RootModule().Resources[clusterResourceName]
+	if !ok {
+		return "", "", fmt.Errorf("Not found: %s", clusterResourceName)
+	}
+
+	rsPrincipal, ok := s.RootModule().Resources[principalResourceName]
+	if !ok {
+		return "", "", fmt.Errorf("Not found: %s", principalResourceName)
+	}
+
+	return rsCluster.Primary.ID, rsPrincipal.Primary.Attributes["arn"], nil
+}
+
+func testAccAccessEntriesExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_iam_user" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_iam_user" "unmanaged" {
+  name = "%[1]s-unmanaged"
+}
+`, rName))
+}
+
+func testAccAccessEntriesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntriesExclusiveConfig_base(rName), `
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  # Keep the cluster creator's access entry.
+  ignore_principal_arns = [data.aws_iam_session_context.current.issuer_arn]
+
+  access_entry {
+    principal_arn = aws_iam_user.test[0].arn
+
+    access_policy {
+      policy_arn = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+      access_scope {
+        type = "cluster"
+      }
+    }
+  }
+
+  access_entry {
+    principal_arn     = aws_iam_user.test[1].arn
+    kubernetes_groups = ["group1"]
+    user_name         = "user1"
+  }
+}
+`)
+}
+
+func testAccAccessEntriesExclusiveConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntriesExclusiveConfig_base(rName), `
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  # Keep the cluster creator's access entry.
+  ignore_principal_arns = [data.aws_iam_session_context.current.issuer_arn]
+
+  access_entry {
+    principal_arn     = aws_iam_user.test[0].arn
+    kubernetes_groups = ["group1", "group2"]
+
+    access_policy {
+      policy_arn = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+      access_scope {
+        type       = "namespace"
+        namespaces = ["default"]
+      }
+    }
+  }
+}
+`)
+}
@@ -271,3 +271,64 @@ func findAccessEntryByTwoPartKey(ctx context.Context, conn *eks.Client, clusterN
 
 	return output.AccessEntry, nil
 }
+
+func findAccessEntryPrincipalARNsByClusterName(ctx context.Context, conn *eks.Client, clusterName string) ([]string, error) {
+	input := &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+	}
+
+	var output []string
+
+	pages := eks.NewListAccessEntriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccessEntries...)
+	}
+
+	return output, nil
+}
+
+func findAccessEntriesByClusterName(ctx context.Context, conn *eks.Client, clusterName string) ([]types.AccessEntry, error) {
+	principalARNs, err := findAccessEntryPrincipalARNsByClusterName(ctx, conn, clusterName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []types.AccessEntry
+
+	for _, principalARN := range principalARNs {
+		accessEntry, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		// The access entry may have been deleted since it was listed.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, *accessEntry)
+	}
+
+	return output, nil
+}
+
+// isServiceLinkedRolePrincipal returns whether an access entry's principal is a service-linked role.
+// EKS creates and deletes the access entries of service-linked roles itself.
+func isServiceLinkedRolePrincipal(principalARN string) bool {
+	return strings.Contains(principalARN, ":role/aws-service-role/")
+}
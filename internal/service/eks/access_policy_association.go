@@ -212,6 +212,15 @@ func findAccessPolicyAssociationByThreePartKey(ctx context.Context, conn *eks.Cl
 	})
 }
 
+func findAssociatedAccessPoliciesByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, principalARN string) ([]types.AssociatedAccessPolicy, error) {
+	input := &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	return findAssociatedAccessPolicies(ctx, conn, input, tfslices.PredicateTrue[*types.AssociatedAccessPolicy]())
+}
+
 func findAssociatedAccessPolicy(ctx context.Context, conn *eks.Client, input *eks.ListAssociatedAccessPoliciesInput, filter tfslices.Predicate[*types.AssociatedAccessPolicy]) (*types.AssociatedAccessPolicy, error) {
 	output, err := findAssociatedAccessPolicies(ctx, conn, input, filter)
 
@@ -15,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,7 +45,11 @@ func resourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resolveAddonVersionConstraint,
+			validateAddonConfigurationValues,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -66,6 +72,7 @@ func resourceAddon() *schema.Resource {
 					// Regular expression taken from: https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
 					validation.StringMatch(regexache.MustCompile(`^v(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[A-Za-z-][0-9A-Za-z-]*)(?:\.(?:0|[1-9]\d*|\d*[A-Za-z-][0-9A-Za-z-]*))*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`), "must follow semantic version format"),
 				),
+				ConflictsWith: []string{"version_constraint"},
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -122,6 +129,12 @@ func resourceAddon() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version_constraint": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  verify.ValidSemVerConstraints,
+				ConflictsWith: []string{"addon_version"},
+			},
 		},
 	}
 }
@@ -142,6 +155,15 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if v, ok := d.GetOk("addon_version"); ok {
 		input.AddonVersion = aws.String(v.(string))
+	} else if v, ok := d.GetOk("version_constraint"); ok {
+		// The cluster didn't exist at plan time.
+		addonVersion, err := findNewestAddonVersionByConstraints(ctx, conn, clusterName, addonName, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resolving EKS Add-On (%s) version constraint (%s): %s", id, v.(string), err)
+		}
+
+		input.AddonVersion = aws.String(addonVersion)
 	}
 
 	if v, ok := d.GetOk("configuration_values"); ok {
@@ -330,6 +352,119 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// resolveAddonVersionConstraint plans the newest add-on version satisfying version_constraint.
+// The version is resolved at create time if the cluster doesn't exist yet.
+func resolveAddonVersionConstraint(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("version_constraint") && d.Get("version_constraint").(string) == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("version_constraint") || !d.NewValueKnown("cluster_name") || !d.NewValueKnown("addon_name") {
+		return d.SetNewComputed("addon_version")
+	}
+
+	constraints := d.Get("version_constraint").(string)
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	if _, err := findClusterByName(ctx, conn, clusterName); tfresource.NotFound(err) {
+		return d.SetNewComputed("addon_version")
+	}
+
+	addonName := d.Get("addon_name").(string)
+	addonVersion, err := findNewestAddonVersionByConstraints(ctx, conn, clusterName, addonName, constraints)
+
+	if err != nil {
+		return fmt.Errorf("resolving EKS Add-On (%s) version constraint (%s): %w", addonName, constraints, err)
+	}
+
+	if addonVersion != d.Get("addon_version").(string) {
+		return d.SetNew("addon_version", addonVersion)
+	}
+
+	return nil
+}
+
+// validateAddonConfigurationValues validates configuration_values against the JSON schema of the planned add-on version.
+func validateAddonConfigurationValues(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The schema can't be chosen until addon_version is known, so configuration_values are then validated by EKS on apply.
+	if !d.NewValueKnown("configuration_values") || !d.NewValueKnown("cluster_name") || !d.NewValueKnown("addon_name") || !d.NewValueKnown("addon_version") {
+		return nil
+	}
+
+	configurationValues := d.Get("configuration_values").(string)
+
+	if configurationValues == "" {
+		return nil
+	}
+
+	// Only call DescribeAddonConfiguration when configuration_values change, so that plans of unchanged
+	// configurations don't require the additional IAM permission.
+	if d.Id() != "" && !d.HasChange("configuration_values") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	addonName := d.Get("addon_name").(string)
+	addonVersion := d.Get("addon_version").(string)
+
+	// Use the add-on's default version for the cluster's Kubernetes version.
+	if addonVersion == "" {
+		if d.Get("version_constraint").(string) != "" {
+			return nil
+		}
+
+		clusterName := d.Get("cluster_name").(string)
+		cluster, err := findClusterByName(ctx, conn, clusterName)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EKS Cluster (%s): %w", clusterName, err)
+		}
+
+		versionInfo, err := findAddonVersionByTwoPartKey(ctx, conn, addonName, aws.ToString(cluster.Version), false)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EKS Add-On (%s) default version: %w", addonName, err)
+		}
+
+		addonVersion = aws.ToString(versionInfo.AddonVersion)
+	}
+
+	configurationSchema, err := findAddonConfigurationSchemaByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Add-On (%s, %s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	configurationSchema, err = cfschema.Sanitize(configurationSchema)
+
+	if err != nil {
+		return fmt.Errorf("sanitizing EKS Add-On (%s, %s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	jsonSchema, err := cfschema.NewResourceJsonSchemaDocument(configurationSchema)
+
+	if err != nil {
+		return fmt.Errorf("parsing EKS Add-On (%s, %s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	if err := jsonSchema.ValidateConfigurationDocument(configurationValues); err != nil {
+		return fmt.Errorf("validating configuration_values against EKS Add-On (%s, %s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
+
 func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, addonName string) (*types.Addon, error) {
 	input := &eks.DescribeAddonInput{
 		AddonName:   aws.String(addonName),
@@ -356,6 +491,77 @@ func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, a
 	return output.Addon, nil
 }
 
+// findNewestAddonVersionByConstraints returns the newest version of an add-on that is compatible with the cluster's Kubernetes version
+// and satisfies the version constraints.
+func findNewestAddonVersionByConstraints(ctx context.Context, conn *eks.Client, clusterName, addonName, constraints string) (string, error) {
+	cluster, err := findClusterByName(ctx, conn, clusterName)
+
+	if err != nil {
+		return "", fmt.Errorf("reading EKS Cluster (%s): %w", clusterName, err)
+	}
+
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: cluster.Version,
+	}
+	var output string
+
+	pages := eks.NewDescribeAddonVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, v := range page.Addons {
+			for _, v := range v.AddonVersions {
+				addonVersion := aws.ToString(v.AddonVersion)
+
+				if !verify.SemVerConstraintsSatisfied(addonVersion, constraints) {
+					continue
+				}
+
+				if output == "" || verify.SemVerLessThan(output, addonVersion) {
+					output = addonVersion
+				}
+			}
+		}
+	}
+
+	if output == "" {
+		return "", fmt.Errorf("no version compatible with Kubernetes version %s", aws.ToString(cluster.Version))
+	}
+
+	return output, nil
+}
+
+func findAddonConfigurationSchemaByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (string, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.ConfigurationSchema == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.ConfigurationSchema), nil
+}
+
 func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) (*types.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -345,7 +345,75 @@ func TestAccEKSAddon_configurationValues(t *testing.T) {
 			},
 			{
 				Config:      testAccAddonConfig_configurationValues(rName, addonName, addonVersion, invalidConfigurationValues, string(types.ResolveConflictsOverwrite)),
-				ExpectError: regexache.MustCompile(`InvalidParameterException: ConfigurationValue provided in request is not supported`),
+				ExpectError: regexache.MustCompile(`validating configuration_values against EKS Add-On \(vpc-cni, v1.15.3-eksbuild.1\) configuration schema`),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddon_configurationValuesUnknownVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var addon types.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	versionDataSourceName := "data.aws_eks_addon_version.test"
+	configurationValues := "{\"env\": {\"WARM_ENI_TARGET\":\"2\"}}"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// addon_version is unknown until the cluster has been created, so configuration_values aren't validated at plan time.
+				Config: testAccAddonConfig_configurationValuesUnknownVersion(rName, addonName, configurationValues),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttrPair(resourceName, "addon_version", versionDataSourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_values", configurationValues),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddon_versionConstraint(t *testing.T) {
+	ctx := acctest.Context(t)
+	var addon types.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAddonConfig_versionConstraint(rName, addonName, "not-a-constraint"),
+				ExpectError: regexache.MustCompile(`is not a valid version constraint`),
+			},
+			{
+				Config: testAccAddonConfig_versionConstraint(rName, addonName, "~> 1.15"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestMatchResourceAttr(resourceName, "addon_version", regexache.MustCompile(`^v1\.\d+\.\d+-eksbuild\.\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "version_constraint", "~> 1.15"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolve_conflicts_on_create", "resolve_conflicts_on_update", "version_constraint"},
+			},
+			{
+				Config:             testAccAddonConfig_versionConstraint(rName, addonName, "~> 1.15"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
 			},
 		},
 	})
@@ -555,6 +623,18 @@ resource "aws_eks_addon" "test" {
 `, rName, addonName, addonVersion))
 }
 
+func testAccAddonConfig_versionConstraint(rName, addonName, versionConstraint string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name                = aws_eks_cluster.test.name
+  addon_name                  = %[2]q
+  version_constraint          = %[3]q
+  resolve_conflicts_on_create = "OVERWRITE"
+  resolve_conflicts_on_update = "OVERWRITE"
+}
+`, rName, addonName, versionConstraint))
+}
+
 func testAccAddonConfig_preserve(rName, addonName string) string {
 	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
 resource "aws_eks_addon" "test" {
@@ -642,6 +722,25 @@ resource "aws_eks_addon" "test" {
 `, rName, addonName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccAddonConfig_configurationValuesUnknownVersion(rName, addonName, configurationValues string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
+data "aws_eks_addon_version" "test" {
+  addon_name         = %[2]q
+  kubernetes_version = aws_eks_cluster.test.version
+  most_recent        = true
+}
+
+resource "aws_eks_addon" "test" {
+  cluster_name         = aws_eks_cluster.test.name
+  addon_name           = %[2]q
+  addon_version        = data.aws_eks_addon_version.test.version
+  configuration_values = %[3]q
+
+  resolve_conflicts_on_create = "OVERWRITE"
+}
+`, rName, addonName, configurationValues))
+}
+
 func testAccAddonConfig_configurationValues(rName, addonName, addonVersion, configurationValues, resolveConflicts string) string {
 	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
 resource "aws_eks_addon" "test" {
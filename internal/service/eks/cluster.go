@@ -49,6 +49,7 @@ func resourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			validateClusterAuthenticationModeChange,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -501,6 +502,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) access configuration update (%s): %s", d.Id(), updateID, err)
 			}
+
+			if v := input.AccessConfig.AuthenticationMode; v != "" {
+				if _, err := waitClusterAuthenticationModeUpdated(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) authentication mode update (%s): %s", d.Id(), v, err)
+				}
+			}
 		}
 	}
 
@@ -747,6 +754,26 @@ func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string)
 	}
 }
 
+func statusClusterAuthenticationMode(ctx context.Context, conn *eks.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.AccessConfig == nil {
+			return output, "", nil
+		}
+
+		return output, string(output.AccessConfig.AuthenticationMode), nil
+	}
+}
+
 func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
@@ -802,6 +829,41 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id
 	return nil, err
 }
 
+// waitClusterAuthenticationModeUpdated waits for a cluster to report the requested authentication mode.
+// The cluster's access configuration can lag behind a successful access configuration update.
+func waitClusterAuthenticationModeUpdated(ctx context.Context, conn *eks.Client, name string, authenticationMode types.AuthenticationMode, timeout time.Duration) (*types.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   append([]string{""}, tfslices.Filter(enum.Values[types.AuthenticationMode](), func(v string) bool { return v != string(authenticationMode) })...),
+		Target:                    enum.Slice(authenticationMode),
+		Refresh:                   statusClusterAuthenticationMode(ctx, conn, name),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func validateClusterAuthenticationModeChange(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("access_config.0.authentication_mode") {
+		return nil
+	}
+
+	// Changing bootstrap_cluster_creator_admin_permissions replaces the cluster.
+	if d.HasChange("access_config.0.bootstrap_cluster_creator_admin_permissions") {
+		return nil
+	}
+
+	o, n := d.GetChange("access_config.0.authentication_mode")
+
+	return validAuthenticationModeTransition(types.AuthenticationMode(o.(string)), types.AuthenticationMode(n.(string)))
+}
+
 func expandCreateAccessConfigRequest(tfList []interface{}) *types.CreateAccessConfigRequest {
 	if len(tfList) == 0 {
 		return nil
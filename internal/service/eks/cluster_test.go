@@ -175,6 +175,19 @@ func TestAccEKSCluster_AccessConfig_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "access_config.0.bootstrap_cluster_creator_admin_permissions", "true"),
 				),
 			},
+			{
+				Config: testAccClusterConfig_accessConfig(rName, types.AuthenticationModeApi),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "access_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_config.0.authentication_mode", string(types.AuthenticationModeApi)),
+					resource.TestCheckResourceAttr(resourceName, "access_config.0.bootstrap_cluster_creator_admin_permissions", "true"),
+				),
+			},
+			{
+				Config:      testAccClusterConfig_accessConfig(rName, types.AuthenticationModeApiAndConfigMap),
+				ExpectError: regexache.MustCompile(`authentication_mode cannot be changed from API to API_AND_CONFIG_MAP`),
+			},
 			{
 				Config: testAccClusterConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -186,6 +199,33 @@ func TestAccEKSCluster_AccessConfig_update(t *testing.T) {
 	})
 }
 
+func TestAccEKSCluster_AccessConfig_invalidTransition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_accessConfig(rName, types.AuthenticationModeConfigMap),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "access_config.0.authentication_mode", string(types.AuthenticationModeConfigMap)),
+				),
+			},
+			{
+				Config:      testAccClusterConfig_accessConfig(rName, types.AuthenticationModeApi),
+				ExpectError: regexache.MustCompile(`authentication_mode cannot be changed from CONFIG_MAP to API directly`),
+			},
+		},
+	})
+}
+
 func TestAccEKSCluster_Encryption_create(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster types.Cluster
@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -122,6 +125,10 @@ func resourceNodeGroup() *schema.Resource {
 							ConflictsWith: []string{"launch_template.0.id"},
 							ValidateFunc:  verify.ValidLaunchTemplateName,
 						},
+						"resolved_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"version": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -339,6 +346,10 @@ func resourceNodeGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	if v := d.Get("launch_template").([]interface{}); len(v) > 0 {
 		input.LaunchTemplate = expandLaunchTemplateSpecification(v)
+
+		if err := resolveLaunchTemplateVersion(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), input.LaunchTemplate); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EKS Node Group (%s): resolving launch template version: %s", groupID, err)
+		}
 	}
 
 	if v, ok := d.GetOk("release_version"); ok {
@@ -409,7 +420,31 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("disk_size", nodeGroup.DiskSize)
 	d.Set("instance_types", nodeGroup.InstanceTypes)
 	d.Set("labels", nodeGroup.Labels)
-	if err := d.Set("launch_template", flattenLaunchTemplateSpecification(nodeGroup.LaunchTemplate)); err != nil {
+	launchTemplate := flattenLaunchTemplateSpecification(nodeGroup.LaunchTemplate)
+	if len(launchTemplate) > 0 {
+		tfMap := launchTemplate[0]
+		tfMap["resolved_version"] = tfMap["version"]
+
+		// Keep "$Latest" or "$Default" in state while the node group uses the version it resolves to.
+		// Otherwise record the concrete version so that the new version is applied on the next update.
+		if v := d.Get("launch_template.0.version").(string); v == tfec2.LaunchTemplateVersionLatest || v == tfec2.LaunchTemplateVersionDefault {
+			apiObject := &types.LaunchTemplateSpecification{
+				Id:      nodeGroup.LaunchTemplate.Id,
+				Version: aws.String(v),
+			}
+
+			err := resolveLaunchTemplateVersion(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), apiObject)
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				return sdkdiag.AppendErrorf(diags, "reading EKS Node Group (%s): resolving launch template version: %s", d.Id(), err)
+			case aws.ToString(apiObject.Version) == tfMap["version"]:
+				tfMap["version"] = v
+			}
+		}
+	}
+	if err := d.Set("launch_template", launchTemplate); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting launch_template: %s", err)
 	}
 	d.Set("node_group_name", nodeGroup.NodegroupName)
@@ -485,6 +520,10 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			if input.LaunchTemplate.Id != nil && input.LaunchTemplate.Name != nil && !d.HasChange("launch_template.0.id") {
 				input.LaunchTemplate.Id = nil
 			}
+
+			if err := resolveLaunchTemplateVersion(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), input.LaunchTemplate); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) version: resolving launch template version: %s", d.Id(), err)
+			}
 		}
 
 		if v, ok := d.GetOk("release_version"); ok && d.HasChange("release_version") {
@@ -503,8 +542,15 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
+		if update, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
+
+			if update != nil && !input.Force && hasErrorDetailCode(update.Errors, types.ErrorCodePodEvictionFailure) {
+				diags = sdkdiag.AppendWarningf(diags, "EKS Node Group (%s) version update (%s) could not drain nodes because pods could not be evicted, "+
+					"typically due to a PodDisruptionBudget. Set force_update_version = true to update regardless of PodDisruptionBudgets.", d.Id(), updateID)
+			}
+
+			return diags
 		}
 	}
 
@@ -660,6 +706,10 @@ func statusNodegroupUpdate(ctx context.Context, conn *eks.Client, clusterName, n
 			return nil, "", err
 		}
 
+		if len(output.Errors) > 0 {
+			log.Printf("[WARN] EKS Node Group (%s) update (%s) status %s: %s", NodeGroupCreateResourceID(clusterName, nodeGroupName), id, output.Status, errorDetailsError(output.Errors))
+		}
+
 		return output, string(output.Status), nil
 	}
 }
@@ -706,18 +756,29 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, no
 	return nil, err
 }
 
-func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
+func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) {
+	// The update's errors are reported on failure and also when the wait times out.
+	var output *types.Update
+	refresh := statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id)
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id),
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if v, ok := outputRaw.(*types.Update); ok {
+				output = v
+			}
+
+			return outputRaw, status, err
+		},
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	_, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*types.Update); ok {
-		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
+	if output != nil {
+		if len(output.Errors) > 0 {
 			tfresource.SetLastError(err, errorDetailsError(output.Errors))
 		}
 
@@ -727,6 +788,16 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, cluste
 	return nil, err
 }
 
+func hasErrorDetailCode(apiObjects []types.ErrorDetail, code types.ErrorCode) bool {
+	for _, apiObject := range apiObjects {
+		if apiObject.ErrorCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
 func issueError(apiObject types.Issue) error {
 	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
 }
@@ -769,6 +840,42 @@ func expandLaunchTemplateSpecification(l []interface{}) *types.LaunchTemplateSpe
 	return config
 }
 
+// resolveLaunchTemplateVersion replaces a "$Latest" or "$Default" launch template version with the concrete version number.
+func resolveLaunchTemplateVersion(ctx context.Context, conn *ec2.EC2, apiObject *types.LaunchTemplateSpecification) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	version := aws.ToString(apiObject.Version)
+
+	if version != tfec2.LaunchTemplateVersionLatest && version != tfec2.LaunchTemplateVersionDefault {
+		return nil
+	}
+
+	input := &ec2.DescribeLaunchTemplatesInput{}
+
+	if v := apiObject.Id; v != nil {
+		input.LaunchTemplateIds = []*string{v}
+	} else {
+		input.LaunchTemplateNames = []*string{apiObject.Name}
+	}
+
+	launchTemplate, err := tfec2.FindLaunchTemplate(ctx, conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	switch version {
+	case tfec2.LaunchTemplateVersionLatest:
+		apiObject.Version = aws.String(strconv.FormatInt(aws.ToInt64(launchTemplate.LatestVersionNumber), 10))
+	case tfec2.LaunchTemplateVersionDefault:
+		apiObject.Version = aws.String(strconv.FormatInt(aws.ToInt64(launchTemplate.DefaultVersionNumber), 10))
+	}
+
+	return nil
+}
+
 func expandNodegroupScalingConfig(tfMap map[string]interface{}) *types.NodegroupScalingConfig {
 	if tfMap == nil {
 		return nil
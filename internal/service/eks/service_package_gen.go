@@ -32,6 +32,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAccessEntries,
+			TypeName: "aws_eks_access_entries",
+			Name:     "Access Entries",
+		},
 		{
 			Factory:  dataSourceAccessEntry,
 			TypeName: "aws_eks_access_entry",
@@ -70,6 +75,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAccessEntriesExclusive,
+			TypeName: "aws_eks_access_entries_exclusive",
+			Name:     "Access Entries Exclusive",
+		},
 		{
 			Factory:  resourceAccessEntry,
 			TypeName: "aws_eks_access_entry",
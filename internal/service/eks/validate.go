@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -26,3 +27,23 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validAuthenticationModeTransition returns an error if a cluster's authentication mode cannot be changed from old to new.
+// The authentication mode can only move one step at a time along CONFIG_MAP -> API_AND_CONFIG_MAP -> API, and never back.
+// https://docs.aws.amazon.com/eks/latest/userguide/setting-up-access-entries.html
+func validAuthenticationModeTransition(old, new types.AuthenticationMode) error {
+	if old == "" || new == "" || old == new {
+		return nil
+	}
+
+	switch {
+	case old == types.AuthenticationModeConfigMap && new == types.AuthenticationModeApiAndConfigMap:
+		return nil
+	case old == types.AuthenticationModeApiAndConfigMap && new == types.AuthenticationModeApi:
+		return nil
+	case old == types.AuthenticationModeConfigMap && new == types.AuthenticationModeApi:
+		return fmt.Errorf("authentication_mode cannot be changed from %[1]s to %[2]s directly, change it to %[3]s first", old, new, types.AuthenticationModeApiAndConfigMap)
+	}
+
+	return fmt.Errorf("authentication_mode cannot be changed from %[1]s to %[2]s, the only allowed changes are %[3]s to %[4]s and %[4]s to %[5]s", old, new, types.AuthenticationModeConfigMap, types.AuthenticationModeApiAndConfigMap, types.AuthenticationModeApi)
+}
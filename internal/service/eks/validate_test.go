@@ -6,6 +6,7 @@ package eks
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 )
 
@@ -66,3 +67,62 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidAuthenticationModeTransition(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Old         types.AuthenticationMode
+		New         types.AuthenticationMode
+		ExpectError bool
+	}{
+		{
+			Old: "",
+			New: types.AuthenticationModeApi,
+		},
+		{
+			Old: types.AuthenticationModeConfigMap,
+			New: "",
+		},
+		{
+			Old: types.AuthenticationModeConfigMap,
+			New: types.AuthenticationModeConfigMap,
+		},
+		{
+			Old: types.AuthenticationModeConfigMap,
+			New: types.AuthenticationModeApiAndConfigMap,
+		},
+		{
+			Old: types.AuthenticationModeApiAndConfigMap,
+			New: types.AuthenticationModeApi,
+		},
+		{
+			Old:         types.AuthenticationModeConfigMap,
+			New:         types.AuthenticationModeApi,
+			ExpectError: true,
+		},
+		{
+			Old:         types.AuthenticationModeApiAndConfigMap,
+			New:         types.AuthenticationModeConfigMap,
+			ExpectError: true,
+		},
+		{
+			Old:         types.AuthenticationModeApi,
+			New:         types.AuthenticationModeApiAndConfigMap,
+			ExpectError: true,
+		},
+		{
+			Old:         types.AuthenticationModeApi,
+			New:         types.AuthenticationModeConfigMap,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validAuthenticationModeTransition(tc.Old, tc.New)
+
+		if got, want := err != nil, tc.ExpectError; got != want {
+			t.Errorf("validAuthenticationModeTransition(%q, %q) error = %v, expected error: %t", tc.Old, tc.New, err, want)
+		}
+	}
+}
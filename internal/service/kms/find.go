@@ -148,6 +148,16 @@ func FindKeyPolicyByKeyIDAndPolicyName(ctx context.Context, conn *kms.KMS, keyID
 }
 
 func FindKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.KMS, keyID string) (*bool, error) {
+	output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.KeyRotationEnabled, nil
+}
+
+func findKeyRotationStatusByKeyID(ctx context.Context, conn *kms.KMS, keyID string) (*kms.GetKeyRotationStatusOutput, error) {
 	input := &kms.GetKeyRotationStatusInput{
 		KeyId: aws.String(keyID),
 	}
@@ -169,5 +179,39 @@ func FindKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.KMS, keyID str
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.KeyRotationEnabled, nil
+	return output, nil
+}
+
+func findKeyRotationsByKeyID(ctx context.Context, conn *kms.KMS, keyID string) ([]*kms.RotationsListEntry, error) {
+	input := &kms.ListKeyRotationsInput{
+		KeyId: aws.String(keyID),
+	}
+	var output []*kms.RotationsListEntry
+
+	err := conn.ListKeyRotationsPagesWithContext(ctx, input, func(page *kms.ListKeyRotationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Rotations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
 }
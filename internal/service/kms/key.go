@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -44,7 +45,10 @@ func ResourceKey() *schema.Resource {
 			Create: schema.DefaultTimeout(2 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			rotationPeriodCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -119,6 +123,13 @@ func ResourceKey() *schema.Resource {
 					return json
 				},
 			},
+			"rotation_period_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(90, 2560),
+				RequiredWith: []string{"enable_key_rotation"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"xks_key_id": {
@@ -187,7 +198,7 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
 
 	if enableKeyRotation := d.Get("enable_key_rotation").(bool); enableKeyRotation {
-		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation); err != nil {
+		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation, d.Get("rotation_period_in_days").(int)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating KMS Key (%s): %s", d.Id(), err)
 		}
 	}
@@ -245,6 +256,7 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("key_id", key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
+	d.Set("rotation_period_in_days", key.rotationPeriodInDays)
 
 	if key.metadata.XksKeyConfiguration != nil {
 		d.Set("xks_key_id", key.metadata.XksKeyConfiguration.Id)
@@ -277,8 +289,8 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if hasChange, enableKeyRotation := d.HasChange("enable_key_rotation"), d.Get("enable_key_rotation").(bool); hasChange {
-		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation); err != nil {
+	if d.HasChanges("enable_key_rotation", "rotation_period_in_days") {
+		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), d.Get("enable_key_rotation").(bool), d.Get("rotation_period_in_days").(int)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating KMS Key (%s): %s", d.Id(), err)
 		}
 	}
@@ -342,10 +354,24 @@ func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 type kmsKey struct {
-	metadata *kms.KeyMetadata
-	policy   string
-	rotation *bool
-	tags     []*kms.Tag
+	metadata             *kms.KeyMetadata
+	policy               string
+	rotation             *bool
+	rotationPeriodInDays *int64
+	tags                 []*kms.Tag
+}
+
+// rotationPeriodCustomizeDiff returns an error if a rotation period is configured without automatic key rotation being enabled.
+func rotationPeriodCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v := d.GetRawConfig().GetAttr("rotation_period_in_days"); !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr("enable_key_rotation"); !v.IsKnown() || (!v.IsNull() && v.True()) {
+		return nil
+	}
+
+	return fmt.Errorf(`"rotation_period_in_days" can only be set when "enable_key_rotation" is true`)
 }
 
 func findKey(ctx context.Context, conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error) {
@@ -373,11 +399,14 @@ func findKey(ctx context.Context, conn *kms.KMS, keyID string, isNewResource boo
 		}
 
 		if aws.StringValue(key.metadata.Origin) == kms.OriginTypeAwsKms {
-			key.rotation, err = FindKeyRotationEnabledByKeyID(ctx, conn, keyID)
+			rotation, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
 
 			if err != nil {
 				return nil, fmt.Errorf("reading KMS Key (%s) rotation enabled: %w", keyID, err)
 			}
+
+			key.rotation = rotation.KeyRotationEnabled
+			key.rotationPeriodInDays = rotation.RotationPeriodInDays
 		}
 
 		tags, err := listTags(ctx, conn, keyID)
@@ -495,7 +524,7 @@ func updateKeyPolicy(ctx context.Context, conn *kms.KMS, keyID string, policy st
 	return nil
 }
 
-func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string, enabled bool) error {
+func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string, enabled bool, rotationPeriodInDays int) error {
 	var action string
 
 	updateFunc := func() (interface{}, error) {
@@ -503,9 +532,15 @@ func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string,
 
 		if enabled {
 			log.Printf("[DEBUG] Enabling KMS Key (%s) key rotation", keyID)
-			_, err = conn.EnableKeyRotationWithContext(ctx, &kms.EnableKeyRotationInput{
+			input := &kms.EnableKeyRotationInput{
 				KeyId: aws.String(keyID),
-			})
+			}
+
+			if rotationPeriodInDays > 0 {
+				input.RotationPeriodInDays = aws.Int64(int64(rotationPeriodInDays))
+			}
+
+			_, err = conn.EnableKeyRotationWithContext(ctx, input)
 		} else {
 			log.Printf("[DEBUG] Disabling KMS Key (%s) key rotation", keyID)
 			_, err = conn.DisableKeyRotationWithContext(ctx, &kms.DisableKeyRotationInput{
@@ -522,7 +557,7 @@ func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string,
 	}
 
 	// Wait for propagation since KMS is eventually consistent.
-	err = WaitKeyRotationEnabledPropagated(ctx, conn, keyID, enabled)
+	err = WaitKeyRotationEnabledPropagated(ctx, conn, keyID, enabled, rotationPeriodInDays)
 
 	if err != nil {
 		return fmt.Errorf("%s key rotation: waiting for completion: %w", action, err)
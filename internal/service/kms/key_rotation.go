@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	keyRotationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_kms_key_rotation", name="Key Rotation")
+func ResourceKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyRotationCreate,
+		ReadWithoutTimeout:   resourceKeyRotationRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"on_demand_rotation_start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceKeyRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	keyID := d.Get("key_id").(string)
+
+	// Rotations listed before this one is requested are used to identify it once it completes.
+	previous, err := findKeyRotationsByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing KMS Key (%s) rotations: %s", keyID, err)
+	}
+
+	input := &kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	_, err = conn.RotateKeyOnDemandWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "rotating KMS Key (%s) on demand: %s", keyID, err)
+	}
+
+	// The rotation start date is only reported while the rotation is in progress.
+	if output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID); err == nil && output.OnDemandRotationStartDate != nil {
+		d.Set("on_demand_rotation_start_date", aws.TimeValue(output.OnDemandRotationStartDate).Format(time.RFC3339))
+	}
+
+	rotation, err := waitKeyOnDemandRotationCompleted(ctx, conn, keyID, previous, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for KMS Key (%s) on-demand rotation: %s", keyID, err)
+	}
+
+	rotationDate := aws.TimeValue(rotation.RotationDate).Format(time.RFC3339)
+	id := errs.Must(flex.FlattenResourceId([]string{keyID, rotationDate}, keyRotationResourceIDPartCount, false))
+	d.SetId(id)
+
+	return append(diags, resourceKeyRotationRead(ctx, d, meta)...)
+}
+
+func resourceKeyRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), keyRotationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	keyID := parts[0]
+	_, err = FindKeyByID(ctx, conn, keyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Key (%s) not found, removing KMS Key Rotation (%s) from state", keyID, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", keyID, err)
+	}
+
+	d.Set("key_id", keyID)
+	d.Set("rotation_date", parts[1])
+
+	return diags
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSKeyRotation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key_rotation.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRotationConfig_basic(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", keyResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotation_date"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config: testAccKeyRotationConfig_basic(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "two"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotation_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyRotationConfig_basic(rName, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key_rotation" "test" {
+  key_id = aws_kms_key.test.id
+
+  triggers = {
+    rotation = %[2]q
+  }
+}
+`, rName, trigger)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_kms_key_rotations", name="Key Rotations")
+func DataSourceKeyRotations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceKeyRotationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_rotation_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"on_demand_rotation_start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation_period_in_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rotations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rotation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeyRotationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	keyID := d.Get("key_id").(string)
+	key, err := FindKeyByID(ctx, conn, keyID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", keyID, err)
+	}
+
+	// Rotation APIs don't accept aliases.
+	keyID = aws.StringValue(key.KeyId)
+	status, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) rotation status: %s", keyID, err)
+	}
+
+	rotations, err := findKeyRotationsByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing KMS Key (%s) rotations: %s", keyID, err)
+	}
+
+	d.SetId(keyID)
+	d.Set("key_rotation_enabled", status.KeyRotationEnabled)
+	if v := status.NextRotationDate; v != nil {
+		d.Set("next_rotation_date", aws.TimeValue(v).Format(time.RFC3339))
+	}
+	if v := status.OnDemandRotationStartDate; v != nil {
+		d.Set("on_demand_rotation_start_date", aws.TimeValue(v).Format(time.RFC3339))
+	}
+	d.Set("rotation_period_in_days", status.RotationPeriodInDays)
+	if err := d.Set("rotations", flattenRotationsListEntries(rotations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rotations: %s", err)
+	}
+
+	return diags
+}
+
+func flattenRotationsListEntries(apiObjects []*kms.RotationsListEntry) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"rotation_type": aws.StringValue(apiObject.RotationType),
+		}
+
+		if v := apiObject.RotationDate; v != nil {
+			tfMap["rotation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSKeyRotationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kms_key_rotations.test"
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRotationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_rotation_enabled", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "next_rotation_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "rotation_period_in_days", "90"),
+					resource.TestCheckResourceAttr(dataSourceName, "rotations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rotations.0.rotation_type", "ON_DEMAND"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyRotationsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+  rotation_period_in_days = 90
+}
+
+resource "aws_kms_key_rotation" "test" {
+  key_id = aws_kms_key.test.id
+}
+
+data "aws_kms_key_rotations" "test" {
+  key_id = aws_kms_key_rotation.test.key_id
+}
+`, rName)
+}
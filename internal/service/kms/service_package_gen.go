@@ -41,6 +41,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceKey,
 			TypeName: "aws_kms_key",
 		},
+		{
+			Factory:  DataSourceKeyRotations,
+			TypeName: "aws_kms_key_rotations",
+			Name:     "Key Rotations",
+		},
 		{
 			Factory:  DataSourcePublicKey,
 			TypeName: "aws_kms_public_key",
@@ -94,6 +99,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceKeyPolicy,
 			TypeName: "aws_kms_key_policy",
 		},
+		{
+			Factory:  ResourceKeyRotation,
+			TypeName: "aws_kms_key_rotation",
+			Name:     "Key Rotation",
+		},
 		{
 			Factory:  ResourceReplicaExternalKey,
 			TypeName: "aws_kms_replica_external_key",
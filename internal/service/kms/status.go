@@ -5,6 +5,7 @@ package kms
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
@@ -12,6 +13,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	keyOnDemandRotationStatusCompleted  = "COMPLETED"
+	keyOnDemandRotationStatusInProgress = "IN_PROGRESS"
+)
+
 func StatusKeyState(ctx context.Context, conn *kms.KMS, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindKeyByID(ctx, conn, id)
@@ -27,3 +33,47 @@ func StatusKeyState(ctx context.Context, conn *kms.KMS, id string) retry.StateRe
 		return output, aws.StringValue(output.KeyState), nil
 	}
 }
+
+// statusKeyOnDemandRotation reports an on-demand rotation as completed once an on-demand rotation that isn't in previous is listed.
+// A rotation that was just requested may not be reported as in progress yet, so its absence alone doesn't indicate completion.
+func statusKeyOnDemandRotation(ctx context.Context, conn *kms.KMS, keyID string, previous []*kms.RotationsListEntry) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status.OnDemandRotationStartDate != nil {
+			return status, keyOnDemandRotationStatusInProgress, nil
+		}
+
+		rotations, err := findKeyRotationsByKeyID(ctx, conn, keyID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range rotations {
+			if aws.StringValue(v.RotationType) != kms.RotationTypeOnDemand {
+				continue
+			}
+
+			if !slices.ContainsFunc(previous, func(p *kms.RotationsListEntry) bool {
+				return aws.TimeValue(p.RotationDate).Equal(aws.TimeValue(v.RotationDate))
+			}) {
+				return v, keyOnDemandRotationStatusCompleted, nil
+			}
+		}
+
+		return status, keyOnDemandRotationStatusInProgress, nil
+	}
+}
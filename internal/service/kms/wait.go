@@ -133,9 +133,9 @@ func WaitKeyPolicyPropagated(ctx context.Context, conn *kms.KMS, id, policy stri
 	return tfresource.WaitUntil(ctx, KeyPolicyPropagationTimeout, checkFunc, opts)
 }
 
-func WaitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.KMS, id string, enabled bool) error {
+func WaitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.KMS, id string, enabled bool, rotationPeriodInDays int) error {
 	checkFunc := func() (bool, error) {
-		output, err := FindKeyRotationEnabledByKeyID(ctx, conn, id)
+		output, err := findKeyRotationStatusByKeyID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return false, nil
@@ -145,7 +145,11 @@ func WaitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.KMS, id str
 			return false, err
 		}
 
-		return aws.BoolValue(output) == enabled, nil
+		if enabled && rotationPeriodInDays > 0 && aws.Int64Value(output.RotationPeriodInDays) != int64(rotationPeriodInDays) {
+			return false, nil
+		}
+
+		return aws.BoolValue(output.KeyRotationEnabled) == enabled, nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 5,
@@ -236,3 +240,22 @@ func WaitReplicaKeyCreated(ctx context.Context, conn *kms.KMS, id string) (*kms.
 
 	return nil, err
 }
+
+func waitKeyOnDemandRotationCompleted(ctx context.Context, conn *kms.KMS, keyID string, previous []*kms.RotationsListEntry, timeout time.Duration) (*kms.RotationsListEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{keyOnDemandRotationStatusInProgress},
+		Target:     []string{keyOnDemandRotationStatusCompleted},
+		Refresh:    statusKeyOnDemandRotation(ctx, conn, keyID, previous),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kms.RotationsListEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -6,8 +6,10 @@ package lambda
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,11 +26,21 @@ func customizeDiffValidateInput(_ context.Context, diff *schema.ResourceDiff, v
 
 	// input is validated to be valid JSON in the schema already.
 	inputNoSpaces := strings.TrimSpace(diff.Get("input").(string))
-	if strings.HasPrefix(inputNoSpaces, "{") && strings.HasSuffix(inputNoSpaces, "}") {
-		return nil
+	if !strings.HasPrefix(inputNoSpaces, "{") || !strings.HasSuffix(inputNoSpaces, "}") {
+		return errors.New(`lifecycle_scope other than "CREATE_ONLY" requires input to be a JSON object`)
+	}
+
+	for _, k := range []string{"delete_input", "update_input"} {
+		if !diff.GetRawPlan().GetAttr(k).IsWhollyKnown() {
+			continue
+		}
+
+		if v := strings.TrimSpace(diff.Get(k).(string)); v != "" && (!strings.HasPrefix(v, "{") || !strings.HasSuffix(v, "}")) {
+			return fmt.Errorf(`lifecycle_scope other than "CREATE_ONLY" requires %s to be a JSON object`, k)
+		}
 	}
 
-	return errors.New(`lifecycle_scope other than "CREATE_ONLY" requires input to be a JSON object`)
+	return nil
 }
 
 // customizeDiffInputChangeWithCreateOnlyScope forces a new resource when `input` has
@@ -39,3 +51,23 @@ func customizeDiffInputChangeWithCreateOnlyScope(_ context.Context, diff *schema
 	}
 	return nil
 }
+
+// customizeDiffValidateInvocationOptions validates that `log_type` is only "Tail" for
+// synchronous invocations and that the per-operation inputs are only used with the "CRUD" `lifecycle_scope`.
+func customizeDiffValidateInvocationOptions(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Get("log_type").(string) == lambda.LogTypeTail && diff.Get("invocation_type").(string) != lambda.InvocationTypeRequestResponse {
+		return fmt.Errorf(`log_type "%s" requires invocation_type "%s"`, lambda.LogTypeTail, lambda.InvocationTypeRequestResponse)
+	}
+
+	if diff.Get("lifecycle_scope").(string) == lifecycleScopeCrud {
+		return nil
+	}
+
+	for _, k := range []string{"delete_input", "update_input"} {
+		if v, ok := diff.GetOk(k); ok && v.(string) != "" {
+			return fmt.Errorf(`%s requires lifecycle_scope "%s"`, k, lifecycleScopeCrud)
+		}
+	}
+
+	return nil
+}
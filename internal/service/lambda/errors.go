@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+const (
+	errCodeUnknownOperationException = "UnknownOperationException"
+)
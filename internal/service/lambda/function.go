@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -169,7 +170,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -208,7 +209,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -293,6 +294,12 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.RecursiveLoop](),
+			},
 			"replace_security_groups_on_destroy": {
 				Deprecated: "AWS no longer supports this operation. This attribute now has " +
 					"no effect and will be removed in a future major version.",
@@ -323,10 +330,31 @@ func ResourceFunction() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[types.Runtime](),
 			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"update_runtime_on": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.UpdateRuntimeOn](),
+						},
+					},
+				},
+			},
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -337,7 +365,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -370,6 +398,38 @@ func ResourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"source": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ExactlyOneOf:  []string{"filename", "image_uri", "s3_bucket", "source"},
+				ConflictsWith: []string{"source_code_hash"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dir": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"excludes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validFunctionSourceExclude,
+							},
+						},
+						"s3_bucket": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"s3_key_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"source.0.s3_bucket"},
+						},
+					},
+				},
+			},
 			"source_code_hash": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -458,6 +518,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateSourceCodeHashFromSource,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -474,18 +535,23 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	functionName := d.Get("function_name").(string)
 	packageType := types.PackageType(d.Get("package_type").(string))
+	publish := d.Get("publish").(bool)
+	// Runtime management configuration can only be applied to $LATEST,
+	// so publishing of the initial version is deferred until it has been set.
+	_, deferPublish := d.GetOk("runtime_management_config")
 	input := &lambda.CreateFunctionInput{
 		Code:         &types.FunctionCode{},
 		Description:  aws.String(d.Get("description").(string)),
 		FunctionName: aws.String(functionName),
 		MemorySize:   aws.Int32(int32(d.Get("memory_size").(int))),
 		PackageType:  packageType,
-		Publish:      d.Get("publish").(bool),
+		Publish:      publish && !deferPublish,
 		Role:         aws.String(d.Get("role").(string)),
 		Tags:         getTagsIn(ctx),
 		Timeout:      aws.Int32(int32(d.Get("timeout").(int))),
 	}
 
+	var sourcePackage *functionSourcePackage
 	if v, ok := d.GetOk("filename"); ok {
 		// Grab an exclusive lock so that we're only reading one function into memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364.
@@ -501,6 +567,24 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if v, ok := d.GetOk("source"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		pkg, err := buildFunctionSourcePackageForApply(ctx, d, meta, v.([]interface{}), functionName)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		sourcePackage = pkg
+
+		if pkg.s3Key != "" {
+			input.Code.S3Bucket = aws.String(pkg.s3Bucket)
+			input.Code.S3Key = aws.String(pkg.s3Key)
+		} else {
+			input.Code.ZipFile = pkg.zipFile
+		}
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -589,6 +673,12 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return conn.CreateFunction(ctx, input)
 	})
 
+	if sourcePackage != nil {
+		if err := sourcePackage.deleteStagedObject(ctx, meta.(*conns.AWSClient).S3Client(ctx)); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "deleting staged Lambda Function (%s) deployment package (%s/%s): %s", functionName, sourcePackage.s3Bucket, sourcePackage.s3Key, err)
+		}
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
 	}
@@ -618,6 +708,46 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("recursive_loop"); ok && types.RecursiveLoop(v.(string)) != types.RecursiveLoopTerminate {
+		if err := putFunctionRecursionConfig(ctx, conn, d.Id(), types.RecursiveLoop(v.(string))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := putRuntimeManagementConfig(ctx, conn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if publish {
+		var version string
+
+		if deferPublish {
+			output, err := publishFunctionVersion(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			version = aws.ToString(output.Version)
+		} else {
+			latest, err := findLatestFunctionVersionByName(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) latest version: %s", d.Id(), err)
+			}
+
+			version = aws.ToString(latest.Version)
+		}
+
+		if snapStartEnabled(d) {
+			if _, err := waitFunctionVersionSnapStartOptimized(ctx, conn, d.Id(), version, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for SnapStart optimization of version %s: %s", d.Id(), version, err)
+			}
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -701,9 +831,6 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
 	d.Set("skip_destroy", d.Get("skip_destroy"))
-	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
-	}
 	d.Set("source_code_hash", function.CodeSha256)
 	d.Set("source_code_size", function.CodeSize)
 	d.Set("timeout", function.Timeout)
@@ -738,9 +865,66 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("qualified_invoke_arn", functionInvokeARN(qualifiedARN, meta))
 		d.Set("version", latest.Version)
 
+		// SnapStart only optimizes published versions, so report the latest published version's optimization status.
+		if function.SnapStart != nil && latest.SnapStart != nil && aws.ToString(latest.Version) != FunctionVersionLatest {
+			function.SnapStart.OptimizationStatus = latest.SnapStart.OptimizationStatus
+		}
+
 		setTagsOut(ctx, output.Tags)
 	}
 
+	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
+	}
+
+	recursionConfig, err := conn.GetFunctionRecursionConfig(ctx, &lambda.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(d.Id()),
+	})
+
+	switch {
+	case err == nil:
+		d.Set("recursive_loop", recursionConfig.RecursiveLoop)
+	case !d.IsNewResource() && errs.IsA[*types.ResourceNotFoundException](err):
+		log.Printf("[WARN] Lambda Function (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	case functionConfigAPIUnsupported(meta.(*conns.AWSClient).Partition, err):
+		log.Printf("[WARN] reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+		d.Set("recursive_loop", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+	}
+
+	// Runtime management is only supported on zip packaged lambda functions.
+	if function.PackageType == types.PackageTypeZip {
+		input := &lambda.GetRuntimeManagementConfigInput{
+			FunctionName: aws.String(d.Id()),
+		}
+		if hasQualifier {
+			input.Qualifier = function.Version
+		}
+
+		runtimeManagementConfig, err := conn.GetRuntimeManagementConfig(ctx, input)
+
+		switch {
+		case err == nil:
+			if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(runtimeManagementConfig)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting runtime_management_config: %s", err)
+			}
+		case !d.IsNewResource() && errs.IsA[*types.ResourceNotFoundException](err):
+			log.Printf("[WARN] Lambda Function (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		case functionConfigAPIUnsupported(meta.(*conns.AWSClient).Partition, err):
+			log.Printf("[WARN] reading Lambda Function (%s) runtime management config: %s", d.Id(), err)
+			d.Set("runtime_management_config", nil)
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) runtime management config: %s", d.Id(), err)
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
+
 	// Currently, this functionality is only enabled in AWS Commercial partition
 	// and other partitions return ambiguous error codes (e.g. AccessDeniedException
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
@@ -795,6 +979,22 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("recursive_loop") {
+		if v, ok := d.GetOk("recursive_loop"); ok {
+			if err := putFunctionRecursionConfig(ctx, conn, d.Id(), types.RecursiveLoop(v.(string))); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	if d.HasChange("runtime_management_config") {
+		if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := putRuntimeManagementConfig(ctx, conn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	configUpdate := needsFunctionConfigUpdate(d)
 	if configUpdate {
 		input := &lambda.UpdateFunctionConfigurationInput{
@@ -939,6 +1139,8 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			FunctionName: aws.String(d.Id()),
 		}
 
+		var sourcePackage *functionSourcePackage
+
 		if d.HasChange("architectures") {
 			if v, ok := d.GetOk("architectures"); ok && len(v.([]interface{})) > 0 {
 				input.Architectures = expandArchitectures(v.([]interface{}))
@@ -964,6 +1166,24 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if v, ok := d.GetOk("source"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			pkg, err := buildFunctionSourcePackageForApply(ctx, d, meta, v.([]interface{}), d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			sourcePackage = pkg
+
+			if pkg.s3Key != "" {
+				input.S3Bucket = aws.String(pkg.s3Bucket)
+				input.S3Key = aws.String(pkg.s3Key)
+			} else {
+				input.ZipFile = pkg.zipFile
+			}
 		} else {
 			input.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -974,6 +1194,12 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		_, err := conn.UpdateFunctionCode(ctx, input)
 
+		if sourcePackage != nil {
+			if err := sourcePackage.deleteStagedObject(ctx, meta.(*conns.AWSClient).S3Client(ctx)); err != nil {
+				diags = sdkdiag.AppendWarningf(diags, "deleting staged Lambda Function (%s) deployment package (%s/%s): %s", d.Id(), sourcePackage.s3Bucket, sourcePackage.s3Key, err)
+			}
+		}
+
 		if err != nil {
 			var ipve *types.InvalidParameterValueException
 			if errors.As(err, &ipve) && strings.Contains(ipve.ErrorMessage(), "Error occurred while GetObject.") {
@@ -1013,37 +1239,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
-		input := &lambda.PublishVersionInput{
-			FunctionName: aws.String(d.Id()),
-		}
-
-		outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
-			func() (interface{}, error) {
-				return conn.PublishVersion(ctx, input)
-			},
-			func(err error) (bool, error) {
-				var rce *types.ResourceConflictException
-				if errors.As(err, &rce) && strings.Contains(rce.ErrorMessage(), "in progress") {
-					return true, err
-				}
-				return false, err
-			},
-		)
+	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish") || d.HasChange("runtime_management_config")) {
+		output, err := publishFunctionVersion(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: %s", d.Id(), err)
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 
-		output := outputRaw.(*lambda.PublishVersionOutput)
-
-		err = lambda.NewFunctionUpdatedWaiter(conn).Wait(ctx, &lambda.GetFunctionConfigurationInput{
-			FunctionName: output.FunctionArn,
-			Qualifier:    output.Version,
-		}, d.Timeout(schema.TimeoutUpdate))
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for completion: %s", d.Id(), err)
+		if snapStartEnabled(d) {
+			if _, err := waitFunctionVersionSnapStartOptimized(ctx, conn, d.Id(), aws.ToString(output.Version), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s): waiting for SnapStart optimization of version %s: %s", d.Id(), aws.ToString(output.Version), err)
+			}
 		}
 	}
 
@@ -1204,6 +1410,141 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 	return nil, err
 }
 
+func statusFunctionVersionSnapStartOptimization(ctx context.Context, conn *lambda.Client, name, version string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFunction(ctx, conn, &lambda.GetFunctionInput{
+			FunctionName: aws.String(name),
+			Qualifier:    aws.String(version),
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		function := output.Configuration
+
+		// The version is optimized once its snapshot has been created and it becomes active.
+		if function.State != types.StateActive {
+			return function, string(function.State), nil
+		}
+
+		if function.SnapStart == nil {
+			return function, string(types.SnapStartOptimizationStatusOff), nil
+		}
+
+		return function, string(function.SnapStart.OptimizationStatus), nil
+	}
+}
+
+func waitFunctionVersionSnapStartOptimized(ctx context.Context, conn *lambda.Client, name, version string, timeout time.Duration) (*types.FunctionConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.StatePending),
+		Target:  enum.Slice(types.SnapStartOptimizationStatusOn),
+		Refresh: statusFunctionVersionSnapStartOptimization(ctx, conn, name, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.FunctionConfiguration); ok {
+		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.StateReasonCode), aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func publishFunctionVersion(ctx context.Context, conn *lambda.Client, name string, timeout time.Duration) (*lambda.PublishVersionOutput, error) {
+	input := &lambda.PublishVersionInput{
+		FunctionName: aws.String(name),
+	}
+
+	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.PublishVersion(ctx, input)
+		},
+		func(err error) (bool, error) {
+			var rce *types.ResourceConflictException
+			if errors.As(err, &rce) && strings.Contains(rce.ErrorMessage(), "in progress") {
+				return true, err
+			}
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("publishing Lambda Function (%s) version: %w", name, err)
+	}
+
+	output := outputRaw.(*lambda.PublishVersionOutput)
+
+	err = lambda.NewFunctionUpdatedWaiter(conn).Wait(ctx, &lambda.GetFunctionConfigurationInput{
+		FunctionName: output.FunctionArn,
+		Qualifier:    output.Version,
+	}, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("publishing Lambda Function (%s) version: waiting for completion: %w", name, err)
+	}
+
+	return output, nil
+}
+
+// functionConfigAPIUnsupported returns whether err indicates that an optional function configuration API,
+// such as recursion or runtime management configuration, isn't available in the current partition or Region.
+func functionConfigAPIUnsupported(partition string, err error) bool {
+	return errs.IsUnsupportedOperationInPartitionError(partition, err) || tfawserr.ErrCodeEquals(err, errCodeUnknownOperationException)
+}
+
+func putFunctionRecursionConfig(ctx context.Context, conn *lambda.Client, name string, recursiveLoop types.RecursiveLoop) error {
+	_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+		FunctionName:  aws.String(name),
+		RecursiveLoop: recursiveLoop,
+	})
+
+	if err != nil {
+		return fmt.Errorf("setting Lambda Function (%s) recursion config: %w", name, err)
+	}
+
+	return nil
+}
+
+func putRuntimeManagementConfig(ctx context.Context, conn *lambda.Client, name string, tfMap map[string]interface{}) error {
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(name),
+		UpdateRuntimeOn: types.UpdateRuntimeOn(tfMap["update_runtime_on"].(string)),
+	}
+
+	// A runtime version can only be specified in Manual mode.
+	if input.UpdateRuntimeOn == types.UpdateRuntimeOnManual {
+		if v, ok := tfMap["runtime_version_arn"].(string); ok && v != "" {
+			input.RuntimeVersionArn = aws.String(v)
+		}
+	}
+
+	_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("setting Lambda Function (%s) runtime management config: %w", name, err)
+	}
+
+	return nil
+}
+
+func snapStartEnabled(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return types.SnapStartApplyOn(v.([]interface{})[0].(map[string]interface{})["apply_on"].(string)) == types.SnapStartApplyOnPublishedVersions
+	}
+
+	return false
+}
+
 // retryFunctionOp retries a Lambda Function Create or Update operation.
 // It handles IAM eventual consistency and EC2 throttling.
 func retryFunctionOp(ctx context.Context, f func() (interface{}, error)) (interface{}, error) { //nolint:unparam
@@ -1266,6 +1607,31 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateSourceCodeHashFromSource sets source_code_hash to the hash of the deployment package built from the source directory.
+func updateSourceCodeHashFromSource(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("source")
+	if !ok {
+		return nil
+	}
+
+	if !d.NewValueKnown("source.0.dir") || !d.NewValueKnown("source.0.excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	src := expandFunctionSource(v.([]interface{}))
+	hash, err := functionSourceHash(src)
+
+	if err != nil {
+		return fmt.Errorf("building deployment package from source directory (%s): %w", src.dir, err)
+	}
+
+	if o, _ := d.GetChange("source_code_hash"); o.(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1315,6 +1681,27 @@ func needsFunctionConfigUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("ephemeral_storage")
 }
 
+// buildFunctionSourcePackageForApply builds the deployment package from the source directory,
+// checking that the source directory hasn't changed since the plan was made.
+func buildFunctionSourcePackageForApply(ctx context.Context, d *schema.ResourceData, meta interface{}, tfList []interface{}, functionName string) (*functionSourcePackage, error) {
+	src := expandFunctionSource(tfList)
+	pkg, err := buildFunctionSourcePackage(ctx, meta.(*conns.AWSClient).S3Client(ctx), src, functionName)
+
+	if err != nil {
+		return nil, fmt.Errorf("building deployment package from source directory (%s): %w", src.dir, err)
+	}
+
+	if want := d.Get("source_code_hash").(string); want != "" && pkg.hash != want {
+		if err := pkg.deleteStagedObject(ctx, meta.(*conns.AWSClient).S3Client(ctx)); err != nil {
+			log.Printf("[WARN] deleting staged Lambda Function (%s) deployment package (%s/%s): %s", functionName, pkg.s3Bucket, pkg.s3Key, err)
+		}
+
+		return nil, fmt.Errorf("deployment package built from source directory (%s) has hash %s, expected %s: source directory changed after plan", src.dir, pkg.hash, want)
+	}
+
+	return pkg, nil
+}
+
 func readFileContents(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
 	if err != nil {
@@ -1516,6 +1903,19 @@ func flattenSnapStart(apiObject *types.SnapStartResponse) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenRuntimeManagementConfig(apiObject *lambda.GetRuntimeManagementConfigOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"runtime_version_arn": aws.ToString(apiObject.RuntimeVersionArn),
+		"update_runtime_on":   string(apiObject.UpdateRuntimeOn),
+	}
+
+	return []interface{}{m}
+}
+
 func expandArchitectures(tfList []interface{}) []types.Architecture {
 	vs := make([]types.Architecture, 0, len(tfList))
 	for _, v := range tfList {
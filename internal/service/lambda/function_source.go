@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/glob"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package that can be uploaded directly via the Lambda API.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	functionSourceDirectUploadLimit = 50 * 1024 * 1024
+)
+
+var (
+	// All archive entries get the same modification time (the earliest representable MS-DOS date)
+	// so that the package's hash depends only on file names and contents.
+	functionSourceModifiedTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type functionSource struct {
+	dir         string
+	excludes    []string
+	s3Bucket    string
+	s3KeyPrefix string
+}
+
+type functionSourcePackage struct {
+	hash     string // Base64-encoded SHA256 hash, as returned in the function's CodeSha256.
+	s3Bucket string
+	s3Key    string
+	zipFile  []byte
+}
+
+func expandFunctionSource(tfList []interface{}) *functionSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	src := &functionSource{
+		dir:         tfMap["dir"].(string),
+		s3Bucket:    tfMap["s3_bucket"].(string),
+		s3KeyPrefix: tfMap["s3_key_prefix"].(string),
+	}
+
+	if v, ok := tfMap["excludes"].(*schema.Set); ok {
+		for _, v := range v.List() {
+			src.excludes = append(src.excludes, v.(string))
+		}
+	}
+
+	return src
+}
+
+// writeFunctionSourceZip writes a ZIP archive of the source directory to w.
+// The archive is reproducible: entries are written in lexical order with fixed timestamps and permissions.
+func writeFunctionSourceZip(src *functionSource, w io.Writer) error {
+	dir, err := homedir.Expand(src.dir)
+	if err != nil {
+		return err
+	}
+
+	if fi, err := os.Stat(dir); err != nil {
+		return err
+	} else if !fi.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+
+	zw := zip.NewWriter(w)
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		if functionSourceExcluded(src.excludes, name) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Symbolic links are followed for files only.
+		fi, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		header := &zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: functionSourceModifiedTime,
+		}
+		if fi.Mode()&0o111 != 0 {
+			header.SetMode(0o755)
+		} else {
+			header.SetMode(0o644)
+		}
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(fw, f)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// functionSourceHash returns the Base64-encoded SHA256 hash of the source directory's deployment package without holding the package in memory.
+func functionSourceHash(src *functionSource) (string, error) {
+	h := sha256.New()
+
+	if err := writeFunctionSourceZip(src, h); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// buildFunctionSourcePackage builds the source directory's deployment package.
+// Packages larger than the direct upload limit are staged in the source's S3 bucket.
+func buildFunctionSourcePackage(ctx context.Context, conn *s3.Client, src *functionSource, functionName string) (*functionSourcePackage, error) {
+	var buf bytes.Buffer
+
+	if err := writeFunctionSourceZip(src, &buf); err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	pkg := &functionSourcePackage{
+		hash: base64.StdEncoding.EncodeToString(sum[:]),
+	}
+
+	if buf.Len() <= functionSourceDirectUploadLimit {
+		pkg.zipFile = buf.Bytes()
+
+		return pkg, nil
+	}
+
+	if src.s3Bucket == "" {
+		return nil, fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes) and source.s3_bucket is not set", buf.Len(), functionSourceDirectUploadLimit)
+	}
+
+	pkg.s3Bucket = src.s3Bucket
+	pkg.s3Key = fmt.Sprintf("%s%s/%s.zip", src.s3KeyPrefix, functionName, hex.EncodeToString(sum[:]))
+
+	_, err := manager.NewUploader(conn).Upload(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(buf.Bytes()),
+		Bucket: aws.String(pkg.s3Bucket),
+		Key:    aws.String(pkg.s3Key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading deployment package to S3 (%s/%s): %w", pkg.s3Bucket, pkg.s3Key, err)
+	}
+
+	return pkg, nil
+}
+
+// deleteStagedObject removes a deployment package staged in S3 once Lambda has copied it.
+func (pkg *functionSourcePackage) deleteStagedObject(ctx context.Context, conn *s3.Client) error {
+	if pkg.s3Key == "" {
+		return nil
+	}
+
+	_, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(pkg.s3Bucket),
+		Key:    aws.String(pkg.s3Key),
+	})
+
+	return err
+}
+
+// functionSourceExcluded returns whether the slash-separated relative path matches any of the exclude patterns.
+func functionSourceExcluded(excludes []string, name string) bool {
+	for _, pattern := range excludes {
+		if glob.MatchPath(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validFunctionSourceExclude(v interface{}, k string) (ws []string, errors []error) {
+	if err := glob.ValidatePattern(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s): %w", k, v, err))
+	}
+
+	return
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFunctionSourceExcluded(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		excludes []string
+		name     string
+		expected bool
+	}{
+		{[]string{"*.pyc"}, "main.pyc", true},
+		{[]string{"*.pyc"}, "lib/main.pyc", false},
+		{[]string{"**/*.pyc"}, "lib/main.pyc", true},
+		{[]string{"**/*.pyc"}, "main.pyc", true},
+		{[]string{"tests"}, "tests", true},
+		{[]string{"tests"}, "lib/tests", false},
+		{[]string{"**/__pycache__"}, "lib/a/__pycache__", true},
+		{[]string{"node_modules/**"}, "node_modules/a/b.js", true},
+		{[]string{"node_modules/**"}, "index.js", false},
+		{[]string{"a/**/z.txt"}, "a/z.txt", true},
+		{[]string{"a/**/z.txt"}, "a/b/c/z.txt", true},
+		{[]string{"a/?.txt"}, "a/b.txt", true},
+		{[]string{"a/?.txt"}, "a/bc.txt", false},
+		{nil, "main.py", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := functionSourceExcluded(testCase.excludes, testCase.name), testCase.expected; got != want {
+			t.Errorf("functionSourceExcluded(%q, %q) = %t, want %t", testCase.excludes, testCase.name, got, want)
+		}
+	}
+}
+
+func TestValidFunctionSourceExclude(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"*.pyc", "**/test_*.py", "dir/[a-z]*"} {
+		if _, errors := validFunctionSourceExclude(v, "excludes"); len(errors) != 0 {
+			t.Errorf("%q should be a valid exclude pattern: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"[", "dir/[a-"} {
+		if _, errors := validFunctionSourceExclude(v, "excludes"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid exclude pattern", v)
+		}
+	}
+}
+
+func TestWriteFunctionSourceZip(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]os.FileMode{
+		"bootstrap":          0o700,
+		"main.py":            0o600,
+		"lib/util.py":        0o664,
+		"lib/util.pyc":       0o644,
+		"tests/test_main.py": 0o644,
+	}
+	for name, mode := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	src := &functionSource{
+		dir:      dir,
+		excludes: []string{"**/*.pyc", "tests"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeFunctionSourceZip(src, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]os.FileMode)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		got[f.Name] = f.Mode().Perm()
+		if !f.Modified.Equal(functionSourceModifiedTime) {
+			t.Errorf("%s: modified time = %s, want %s", f.Name, f.Modified, functionSourceModifiedTime)
+		}
+	}
+
+	if diff := cmp.Diff(names, []string{"bootstrap", "lib/util.py", "main.py"}); diff != "" {
+		t.Errorf("unexpected entries (-got +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(got, map[string]os.FileMode{"bootstrap": 0o755, "lib/util.py": 0o644, "main.py": 0o644}); diff != "" {
+		t.Errorf("unexpected modes (-got +want):\n%s", diff)
+	}
+
+	hash1, err := functionSourceHash(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Changing timestamps and non-executable permission bits doesn't change the package.
+	p := filepath.Join(dir, "main.py")
+	if err := os.Chtimes(p, time.Now(), time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(p, 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	hash2, err := functionSourceHash(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("package hash changed: %s != %s", hash1, hash2)
+	}
+
+	// Changing contents does.
+	if err := os.WriteFile(p, []byte("changed"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	hash3, err := functionSourceHash(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash1 == hash3 {
+		t.Errorf("package hash unchanged after content change: %s", hash1)
+	}
+}
@@ -6,9 +6,11 @@ package lambda
 import (
 	"context"
 	"crypto/md5"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
@@ -31,6 +33,35 @@ func ResourceInvocation() *schema.Resource {
 		UpdateWithoutTimeout: resourceInvocationUpdate,
 
 		Schema: map[string]*schema.Schema{
+			"delete_input": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"executed_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_error_retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delay_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntBetween(0, 300),
+						},
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
 			"function_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -41,6 +72,22 @@ func ResourceInvocation() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringIsJSON,
 			},
+			"invocation_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lambda.InvocationTypeRequestResponse,
+				ValidateFunc: validation.StringInSlice(lambda.InvocationType_Values(), false),
+			},
+			"log_result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lambda.LogTypeNone,
+				ValidateFunc: validation.StringInSlice(lambda.LogType_Values(), false),
+			},
 			"qualifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -68,10 +115,16 @@ func ResourceInvocation() *schema.Resource {
 				Optional: true,
 				Default:  defaultInvocationTerraformKey,
 			},
+			"update_input": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
 		},
 		CustomizeDiff: customdiff.Sequence(
 			customizeDiffValidateInput,
 			customizeDiffInputChangeWithCreateOnlyScope,
+			customizeDiffValidateInvocationOptions,
 		),
 	}
 }
@@ -86,6 +139,13 @@ func resourceInvocationRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceInvocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Arguments that only affect later invocations don't trigger an invocation.
+	if !d.HasChangesExcept("delete_input", "function_error_retry") {
+		return diags
+	}
+
 	return invoke(ctx, invocationActionUpdate, d, meta)
 }
 
@@ -108,6 +168,8 @@ func resourceInvocationDelete(ctx context.Context, d *schema.ResourceData, meta
 //
 // Because Lambda functions by default are stateless we must pass the input from the previous
 // invocation to allow implementation of delete/update at Lambda side.
+//
+// If `update_input` or `delete_input` is set it replaces `input` as the payload for that action.
 func buildInput(d *schema.ResourceData, action string) ([]byte, error) {
 	if isCreateOnlyScope(d) {
 		jsonBytes := []byte(d.Get("input").(string))
@@ -119,6 +181,17 @@ func buildInput(d *schema.ResourceData, action string) ([]byte, error) {
 		return nil, err
 	}
 
+	if v, ok := d.GetOk(action + "_input"); ok {
+		newInputMap, err = getObjectFromJSONString(v.(string))
+		if err != nil {
+			log.Printf("[ERROR] %s input serialization '%s'", action, v.(string))
+			return nil, err
+		}
+	}
+	if newInputMap == nil {
+		newInputMap = make(map[string]interface{})
+	}
+
 	newInputMap[d.Get("terraform_key").(string)] = map[string]interface{}{
 		"action":     action,
 		"prev_input": oldInputMap,
@@ -173,23 +246,86 @@ func invoke(ctx context.Context, action string, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "Lambda Invocation (%s) input transformation failed for input (%s): %s", d.Id(), d.Get("input").(string), err)
 	}
 
-	res, err := conn.InvokeWithContext(ctx, &lambda.InvokeInput{
+	var maxRetries int
+	var delay time.Duration
+	if v, ok := d.GetOk("function_error_retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		maxRetries = tfMap["max_retries"].(int)
+		delay = time.Duration(tfMap["delay_seconds"].(int)) * time.Second
+	}
+
+	res, err := invokeWithFunctionErrorRetry(ctx, conn, &lambda.InvokeInput{
 		FunctionName:   aws.String(functionName),
-		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		InvocationType: aws.String(d.Get("invocation_type").(string)),
+		LogType:        aws.String(d.Get("log_type").(string)),
 		Payload:        input,
 		Qualifier:      aws.String(qualifier),
-	})
+	}, maxRetries, delay)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "Lambda Invocation (%s) failed: %s", d.Id(), err)
 	}
 
+	logResult, err := decodeLogResult(res.LogResult)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "Lambda Invocation (%s) log result: %s", d.Id(), err)
+	}
+
 	if res.FunctionError != nil {
-		return sdkdiag.AppendErrorf(diags, "Lambda function (%s) returned error: (%s)", functionName, string(res.Payload))
+		return sdkdiag.AppendErrorf(diags, "Lambda function (%s) returned error: (%s)%s", functionName, string(res.Payload), logResultSuffix(logResult))
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s_%x", functionName, qualifier, md5.Sum(input)))
+	d.Set("executed_version", res.ExecutedVersion)
+	d.Set("log_result", logResult)
 	d.Set("result", string(res.Payload))
 
 	return diags
 }
+
+// invokeWithFunctionErrorRetry invokes a Lambda function, retrying up to maxRetries times
+// when the function itself returns an error (i.e. the response includes a FunctionError).
+// The last response is returned when retries are exhausted.
+func invokeWithFunctionErrorRetry(ctx context.Context, conn *lambda.Lambda, input *lambda.InvokeInput, maxRetries int, delay time.Duration) (*lambda.InvokeOutput, error) {
+	for attempt := 0; ; attempt++ {
+		output, err := conn.InvokeWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output.FunctionError == nil || attempt >= maxRetries {
+			return output, nil
+		}
+
+		log.Printf("[WARN] Lambda function (%s) returned error (%s), retrying (%d/%d): %s", aws.StringValue(input.FunctionName), aws.StringValue(output.FunctionError), attempt+1, maxRetries, string(output.Payload))
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// decodeLogResult decodes the Base64-encoded tail of the execution log returned when LogType is Tail.
+func decodeLogResult(v *string) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+
+	b, err := base64.StdEncoding.DecodeString(aws.StringValue(v))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func logResultSuffix(logResult string) string {
+	if logResult == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\nLog tail:\n%s", logResult)
+}
@@ -6,6 +6,7 @@ package lambda
 import (
 	"context"
 	"crypto/md5"
+	"encoding/json"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -40,10 +41,40 @@ func DataSourceInvocation() *schema.Resource {
 				ValidateFunc: validation.StringIsJSON,
 			},
 
+			"invocation_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lambda.InvocationTypeRequestResponse,
+				ValidateFunc: validation.StringInSlice([]string{lambda.InvocationTypeDryRun, lambda.InvocationTypeRequestResponse}, false),
+			},
+
+			"log_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lambda.LogTypeNone,
+				ValidateFunc: validation.StringInSlice(lambda.LogType_Values(), false),
+			},
+
+			"executed_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"log_result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"result": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"result_map": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -55,10 +86,17 @@ func dataSourceInvocationRead(ctx context.Context, d *schema.ResourceData, meta
 	functionName := d.Get("function_name").(string)
 	qualifier := d.Get("qualifier").(string)
 	input := []byte(d.Get("input").(string))
+	invocationType := d.Get("invocation_type").(string)
+	logType := d.Get("log_type").(string)
+
+	if logType == lambda.LogTypeTail && invocationType != lambda.InvocationTypeRequestResponse {
+		return sdkdiag.AppendErrorf(diags, `log_type "%s" requires invocation_type "%s"`, lambda.LogTypeTail, lambda.InvocationTypeRequestResponse)
+	}
 
 	res, err := conn.InvokeWithContext(ctx, &lambda.InvokeInput{
 		FunctionName:   aws.String(functionName),
-		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		InvocationType: aws.String(invocationType),
+		LogType:        aws.String(logType),
 		Payload:        input,
 		Qualifier:      aws.String(qualifier),
 	})
@@ -67,13 +105,59 @@ func dataSourceInvocationRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "invoking Lambda Function (%s): %s", functionName, err)
 	}
 
+	logResult, err := decodeLogResult(res.LogResult)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "invoking Lambda Function (%s): log result: %s", functionName, err)
+	}
+
 	if res.FunctionError != nil {
-		return sdkdiag.AppendErrorf(diags, `invoking Lambda Function (%s): returned error: "%s"`, functionName, string(res.Payload))
+		return sdkdiag.AppendErrorf(diags, `invoking Lambda Function (%s): returned error: "%s"%s`, functionName, string(res.Payload), logResultSuffix(logResult))
 	}
 
+	resultMap, err := flattenInvocationResultMap(res.Payload)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "invoking Lambda Function (%s): decoding result: %s", functionName, err)
+	}
+
+	d.Set("executed_version", res.ExecutedVersion)
+	d.Set("log_result", logResult)
 	d.Set("result", string(res.Payload))
+	d.Set("result_map", resultMap)
 
 	d.SetId(fmt.Sprintf("%s_%s_%x", functionName, qualifier, md5.Sum(input)))
 
 	return diags
 }
+
+// flattenInvocationResultMap decodes a JSON object result into a map of strings.
+// String values are used as-is and all other values are JSON-encoded.
+// Results that aren't JSON objects produce an empty map.
+func flattenInvocationResultMap(payload []byte) (map[string]string, error) {
+	var result interface{}
+
+	if err := json.Unmarshal(payload, &result); err != nil {
+		return nil, nil //nolint:nilerr // Not a JSON result.
+	}
+
+	tfMap, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	m := make(map[string]string, len(tfMap))
+	for k, v := range tfMap {
+		if v, ok := v.(string); ok {
+			m[k] = v
+			continue
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		m[k] = string(b)
+	}
+
+	return m, nil
+}
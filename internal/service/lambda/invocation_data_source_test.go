@@ -100,6 +100,51 @@ func TestAccLambdaInvocationDataSource_complex(t *testing.T) {
 	})
 }
 
+func TestAccLambdaInvocationDataSource_resultMap(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_invocation.invocation_test"
+	testData := "value3"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvocationDataSourceConfig_complex(rName, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "executed_version", "$LATEST"),
+					resource.TestCheckResourceAttr(dataSourceName, "result_map.%", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "result_map.key1", `{"subkey1":"subvalue1"}`),
+					resource.TestCheckResourceAttr(dataSourceName, "result_map.key3", testData),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvocationDataSource_dryRun(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_invocation.invocation_test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvocationDataSourceConfig_dryRun(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "result_map.%", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInvocationDataSource_base_config(roleName string) string {
 	return fmt.Sprintf(`
 data "aws_iam_policy_document" "lambda_assume_role_policy" {
@@ -230,3 +275,24 @@ JSON
 }
 `, rName, testData)
 }
+
+func testAccInvocationDataSourceConfig_dryRun(rName string) string {
+	return fmt.Sprintf(testAccInvocationDataSource_base_config(rName)+`
+resource "aws_lambda_function" "lambda" {
+  depends_on = [aws_iam_role_policy_attachment.lambda_role_policy]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = "%s"
+  role          = aws_iam_role.lambda_role.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs16.x"
+}
+
+data "aws_lambda_invocation" "invocation_test" {
+  function_name   = aws_lambda_function.lambda.function_name
+  invocation_type = "DryRun"
+
+  input = jsonencode({})
+}
+`, rName)
+}
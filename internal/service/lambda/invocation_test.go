@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -328,6 +329,125 @@ func TestAccLambdaInvocation_lifecycle_scopeCreateOnlyToCRUD(t *testing.T) {
 	})
 }
 
+func TestAccLambdaInvocation_lifecycle_scopeCRUDUpdateInputArgument(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_invocation.test"
+	fName := "lambda_invocation_crud"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	inputJSON := `{"key1":"value1","key2":"value2"}`
+	resultJSON := `{"key1":"value1","key2":"value2","tf":{"action":"create", "prev_input": null}}`
+	updateInputJSON := `{"key1":"valueU"}`
+	inputJSON2 := `{"key1":"valueB","key2":"value2"}`
+	resultJSON2 := fmt.Sprintf(`{"key1":"valueU","tf":{"action":"update", "prev_input": %s}}`, inputJSON)
+
+	extraArgs := fmt.Sprintf("lifecycle_scope = \"CRUD\"\nupdate_input = %s", strconv.Quote(updateInputJSON))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccInvocationConfig_function(fName, rName, ""),
+					testAccInvocationConfig_invocation(inputJSON, extraArgs),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvocationResult(resourceName, resultJSON),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					testAccInvocationConfig_function(fName, rName, ""),
+					testAccInvocationConfig_invocation(inputJSON2, extraArgs),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvocationResult(resourceName, resultJSON2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvocation_invocationTypeEvent(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_invocation.test"
+	fName := "lambda_invocation"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	inputJSON := `{"key1":"value1","key2":"value2"}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccInvocationConfig_function(fName, rName, ""),
+					testAccInvocationConfig_invocation(inputJSON, `invocation_type = "Event"`),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "invocation_type", "Event"),
+					resource.TestCheckResourceAttr(resourceName, "log_result", ""),
+					resource.TestCheckResourceAttr(resourceName, "result", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvocation_logTail(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_invocation.test"
+	fName := "lambda_invocation"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	inputJSON := `{"key1":"value1","key2":"value2"}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccInvocationConfig_function(fName, rName, ""),
+					testAccInvocationConfig_invocation(inputJSON, "log_type = \"Tail\"\n\nfunction_error_retry {\n  max_retries = 2\n}"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "executed_version", "$LATEST"),
+					resource.TestMatchResourceAttr(resourceName, "log_result", regexache.MustCompile(`REPORT RequestId:`)),
+					testAccCheckInvocationResult(resourceName, inputJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvocation_logTailInvalidInvocationType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccInvocationConfig_function("lambda_invocation", rName, ""),
+					testAccInvocationConfig_invocation(`{}`, "invocation_type = \"Event\"\nlog_type = \"Tail\""),
+				),
+				ExpectError: regexache.MustCompile(`log_type "Tail" requires invocation_type "RequestResponse"`),
+			},
+		},
+	})
+}
+
 func TestAccLambdaInvocation_terraformKey(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lambda_invocation.test"
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_anomaly_detector", name="Anomaly Detector")
+// @Tags(identifierAttribute="arn")
+func resourceAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalyDetectorCreate,
+		ReadWithoutTimeout:   resourceAnomalyDetectorRead,
+		UpdateWithoutTimeout: resourceAnomalyDetectorUpdate,
+		DeleteWithoutTimeout: resourceAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"anomaly_visibility_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 90),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detector_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"evaluation_frequency": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.EvaluationFrequency](),
+			},
+			"filter_pattern": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"log_group_arn_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAnomalyDetectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	input := &cloudwatchlogs.CreateLogAnomalyDetectorInput{
+		LogGroupArnList: flex.ExpandStringValueSet(d.Get("log_group_arn_list").(*schema.Set)),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("anomaly_visibility_time"); ok {
+		input.AnomalyVisibilityTime = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("detector_name"); ok {
+		input.DetectorName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_frequency"); ok {
+		input.EvaluationFrequency = types.EvaluationFrequency(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_pattern"); ok {
+		input.FilterPattern = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateLogAnomalyDetector(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Logs Anomaly Detector: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.AnomalyDetectorArn))
+
+	// New anomaly detectors are enabled.
+	if !d.Get("enabled").(bool) {
+		if err := updateAnomalyDetector(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAnomalyDetectorRead(ctx, d, meta)...)
+}
+
+func resourceAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	output, err := findAnomalyDetectorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	d.Set("anomaly_visibility_time", output.AnomalyVisibilityTime)
+	d.Set("arn", d.Id())
+	d.Set("detector_name", output.DetectorName)
+	d.Set("enabled", output.AnomalyDetectorStatus != types.AnomalyDetectorStatusPaused)
+	d.Set("evaluation_frequency", output.EvaluationFrequency)
+	d.Set("filter_pattern", output.FilterPattern)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("log_group_arn_list", output.LogGroupArnList)
+	d.Set("status", output.AnomalyDetectorStatus)
+
+	return diags
+}
+
+func resourceAnomalyDetectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		if err := updateAnomalyDetector(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAnomalyDetectorRead(ctx, d, meta)...)
+}
+
+func resourceAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteLogAnomalyDetector(ctx, &cloudwatchlogs.DeleteLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateAnomalyDetector sends the full configuration, as UpdateLogAnomalyDetector requires the enabled flag.
+func updateAnomalyDetector(ctx context.Context, conn *cloudwatchlogs.Client, d *schema.ResourceData) error {
+	input := &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(d.Id()),
+		Enabled:            aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("anomaly_visibility_time"); ok {
+		input.AnomalyVisibilityTime = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("evaluation_frequency"); ok {
+		input.EvaluationFrequency = types.EvaluationFrequency(v.(string))
+	}
+
+	// An empty filter pattern removes a previously configured one.
+	if v := d.Get("filter_pattern").(string); v != "" || d.HasChange("filter_pattern") {
+		input.FilterPattern = aws.String(v)
+	}
+
+	_, err := conn.UpdateLogAnomalyDetector(ctx, input)
+
+	return err
+}
+
+func findAnomalyDetectorByARN(ctx context.Context, conn *cloudwatchlogs.Client, arn string) (*cloudwatchlogs.GetLogAnomalyDetectorOutput, error) {
+	input := &cloudwatchlogs.GetLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLogAnomalyDetector(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.AnomalyDetectorStatus == types.AnomalyDetectorStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(output.AnomalyDetectorStatus),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -5,7 +5,12 @@ package logs
 
 // Exports for use in tests only.
 var (
+	ResourceAccountPolicy        = resourceAccountPolicy
+	ResourceAnomalyDetector      = resourceAnomalyDetector
 	ResourceDataProtectionPolicy = resourceDataProtectionPolicy
+	ResourceDelivery             = resourceDelivery
+	ResourceDeliveryDestination  = resourceDeliveryDestination
+	ResourceDeliverySource       = resourceDeliverySource
 	ResourceDestination          = resourceDestination
 	ResourceDestinationPolicy    = resourceDestinationPolicy
 	ResourceGroup                = resourceGroup
@@ -15,6 +20,11 @@ var (
 	ResourceStream               = resourceStream
 	ResourceSubscriptionFilter   = resourceSubscriptionFilter
 
+	FindAccountPolicyByTwoPartKey      = findAccountPolicyByTwoPartKey
+	FindAnomalyDetectorByARN           = findAnomalyDetectorByARN
+	FindDeliveryByID                   = findDeliveryByID
+	FindDeliveryDestinationByName      = findDeliveryDestinationByName
+	FindDeliverySourceByName           = findDeliverySourceByName
 	FindDestinationByName              = findDestinationByName
 	FindLogGroupByName                 = findLogGroupByName
 	FindLogStreamByTwoPartKey          = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_cloudwatch_log_query", name="Query")
+func dataSourceQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceQueryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10000),
+			},
+			"log_group_identifiers": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MaxItems:     50,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"log_group_identifiers", "log_group_names"},
+			},
+			"log_group_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validLogGroupName,
+				},
+				ExactlyOneOf: []string{"log_group_identifiers", "log_group_names"},
+			},
+			"query_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_scanned": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"records_matched": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"records_scanned": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	endTime, _ := time.Parse(time.RFC3339, d.Get("end_time").(string))
+
+	if !startTime.Before(endTime) {
+		return sdkdiag.AppendErrorf(diags, "start_time (%s) must be before end_time (%s)", d.Get("start_time").(string), d.Get("end_time").(string))
+	}
+
+	input := &cloudwatchlogs.StartQueryInput{
+		EndTime:     aws.Int64(endTime.Unix()),
+		QueryString: aws.String(d.Get("query_string").(string)),
+		StartTime:   aws.Int64(startTime.Unix()),
+	}
+
+	if v, ok := d.GetOk("limit"); ok {
+		input.Limit = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("log_group_identifiers"); ok && v.(*schema.Set).Len() > 0 {
+		input.LogGroupIdentifiers = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("log_group_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.LogGroupNames = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	output, err := conn.StartQuery(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting CloudWatch Logs Query: %s", err)
+	}
+
+	queryID := aws.ToString(output.QueryId)
+
+	var results *cloudwatchlogs.GetQueryResultsOutput
+
+	// Don't leave the query running, and billed, if waiting stops before the query reaches a final status.
+	defer func() {
+		if results != nil && queryStatusFinal(results.Status) {
+			return
+		}
+
+		// The context may already be cancelled.
+		if _, err := conn.StopQuery(context.WithoutCancel(ctx), &cloudwatchlogs.StopQueryInput{QueryId: aws.String(queryID)}); err != nil {
+			log.Printf("[WARN] Stopping CloudWatch Logs Query (%s): %s", queryID, err)
+		}
+	}()
+
+	results, err = waitQueryCompleted(ctx, conn, queryID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Logs Query (%s) complete: %s", queryID, err)
+	}
+
+	d.SetId(queryID)
+	d.Set("query_id", queryID)
+	if err := d.Set("results", flattenQueryResults(results.Results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+	if err := d.Set("statistics", flattenQueryStatistics(results.Statistics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting statistics: %s", err)
+	}
+	d.Set("status", results.Status)
+
+	return diags
+}
+
+func findQueryResultsByID(ctx context.Context, conn *cloudwatchlogs.Client, id string) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	input := &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryResults(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusQuery(ctx context.Context, conn *cloudwatchlogs.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueryResultsByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueryCompleted(ctx context.Context, conn *cloudwatchlogs.Client, id string, timeout time.Duration) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.QueryStatusScheduled, types.QueryStatusRunning),
+		Target:     enum.Slice(types.QueryStatusComplete),
+		Refresh:    statusQuery(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetQueryResultsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenQueryResults converts each result row into a map of field name to value.
+func queryStatusFinal(status types.QueryStatus) bool {
+	switch status {
+	case types.QueryStatusComplete, types.QueryStatusFailed, types.QueryStatusCancelled, types.QueryStatusTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func flattenQueryResults(apiObjects [][]types.ResultField) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, row := range apiObjects {
+		tfMap := make(map[string]interface{}, len(row))
+
+		for _, field := range row {
+			tfMap[aws.ToString(field.Field)] = aws.ToString(field.Value)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenQueryStatistics(apiObject *types.QueryStatistics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"bytes_scanned":   apiObject.BytesScanned,
+		"records_matched": apiObject.RecordsMatched,
+		"records_scanned": apiObject.RecordsScanned,
+	}}
+}
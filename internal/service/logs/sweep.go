@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -51,6 +52,37 @@ func RegisterSweepers() {
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
+
+	resource.AddTestSweepers("aws_cloudwatch_log_account_policy", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_account_policy",
+		F:    sweepAccountPolicies,
+	})
+
+	resource.AddTestSweepers("aws_cloudwatch_log_anomaly_detector", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_anomaly_detector",
+		F:    sweepAnomalyDetectors,
+	})
+
+	resource.AddTestSweepers("aws_cloudwatch_log_delivery", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery",
+		F:    sweepDeliveries,
+	})
+
+	resource.AddTestSweepers("aws_cloudwatch_log_delivery_destination", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery_destination",
+		F:    sweepDeliveryDestinations,
+		Dependencies: []string{
+			"aws_cloudwatch_log_delivery",
+		},
+	})
+
+	resource.AddTestSweepers("aws_cloudwatch_log_delivery_source", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery_source",
+		F:    sweepDeliverySources,
+		Dependencies: []string{
+			"aws_cloudwatch_log_delivery",
+		},
+	})
 }
 
 func sweepGroups(region string) error {
@@ -181,3 +213,211 @@ func sweepResourcePolicies(region string) error {
 
 	return nil
 }
+
+func sweepAccountPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for _, policyType := range types.PolicyType("").Values() {
+		input := &cloudwatchlogs.DescribeAccountPoliciesInput{
+			PolicyType: policyType,
+		}
+
+		output, err := conn.DescribeAccountPolicies(ctx, input)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Account Policy sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Account Policies (%s): %w", region, err)
+		}
+
+		for _, v := range output.AccountPolicies {
+			r := resourceAccountPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.PolicyName))
+			d.Set("policy_type", v.PolicyType)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Account Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAnomalyDetectors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.ListLogAnomalyDetectorsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewListLogAnomalyDetectorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Anomaly Detector sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Anomaly Detectors (%s): %w", region, err)
+		}
+
+		for _, v := range page.AnomalyDetectors {
+			r := resourceAnomalyDetector()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.AnomalyDetectorArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Anomaly Detectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliveries(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliveriesInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliveriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Deliveries (%s): %w", region, err)
+		}
+
+		for _, v := range page.Deliveries {
+			r := resourceDelivery()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Deliveries (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliveryDestinations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliveryDestinationsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliveryDestinationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery Destination sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Delivery Destinations (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeliveryDestinations {
+			r := resourceDeliveryDestination()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Delivery Destinations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliverySources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliverySourcesInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliverySourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery Source sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Delivery Sources (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeliverySources {
+			r := resourceDeliverySource()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Delivery Sources (%s): %w", region, err)
+	}
+
+	return nil
+}
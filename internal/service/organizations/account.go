@@ -6,7 +6,9 @@ package organizations
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -34,7 +37,12 @@ func ResourceAccount() *schema.Resource {
 		UpdateWithoutTimeout: resourceAccountUpdate,
 		DeleteWithoutTimeout: resourceAccountDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceAccountImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,6 +60,11 @@ func ResourceAccount() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"deny_deletion_with_attached_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"email": {
 				ForceNew: true,
 				Type:     schema.TypeString,
@@ -138,7 +151,11 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "creating AWS Organizations Account (%s): %s", d.Get("name").(string), err)
 	}
 
-	output, err := waitAccountCreated(ctx, conn, aws.StringValue(s.Id))
+	output, err := waitAccountCreated(ctx, conn, aws.StringValue(s.Id), d.Timeout(schema.TimeoutCreate))
+
+	if output != nil && aws.StringValue(output.State) == organizations.CreateAccountStateFailed {
+		return append(diags, createAccountFailureDiagnostic(d.Get("name").(string), output))
+	}
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) create: %s", d.Get("name").(string), err)
@@ -155,14 +172,7 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if newParentAccountID := v.(string); newParentAccountID != oldParentAccountID {
-			input := &organizations.MoveAccountInput{
-				AccountId:           aws.String(d.Id()),
-				DestinationParentId: aws.String(newParentAccountID),
-				SourceParentId:      aws.String(oldParentAccountID),
-			}
-
-			log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
-			if _, err := conn.MoveAccountWithContext(ctx, input); err != nil {
+			if err := moveAccount(ctx, conn, d.Id(), oldParentAccountID, newParentAccountID); err != nil {
 				return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
 			}
 		}
@@ -209,16 +219,17 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
 
 	if d.HasChange("parent_id") {
-		o, n := d.GetChange("parent_id")
+		// Use the current parent rather than the one in state, which may be stale if the account was moved outside Terraform.
+		oldParentAccountID, err := findParentAccountID(ctx, conn, d.Id())
 
-		input := &organizations.MoveAccountInput{
-			AccountId:           aws.String(d.Id()),
-			SourceParentId:      aws.String(o.(string)),
-			DestinationParentId: aws.String(n.(string)),
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading AWS Organizations Account (%s) parent: %s", d.Id(), err)
 		}
 
-		if _, err := conn.MoveAccountWithContext(ctx, input); err != nil {
-			return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
+		if newParentAccountID := d.Get("parent_id").(string); newParentAccountID != oldParentAccountID {
+			if err := moveAccount(ctx, conn, d.Id(), oldParentAccountID, newParentAccountID); err != nil {
+				return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -229,6 +240,20 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
 
+	if d.Get("deny_deletion_with_attached_policies").(bool) {
+		policies, err := findAttachedServiceControlPolicyExemptions(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading AWS Organizations Account (%s) attached policies: %s", d.Id(), err)
+		}
+
+		if len(policies) > 0 {
+			return sdkdiag.AppendErrorf(diags, "deleting AWS Organizations Account (%s): account has directly attached service control policies (%s); detach them or set deny_deletion_with_attached_policies to false", d.Id(), strings.Join(tfslices.ApplyToAll(policies, func(v *organizations.PolicySummary) string {
+				return aws.StringValue(v.Id)
+			}), ", "))
+		}
+	}
+
 	close := d.Get("close_on_deletion").(bool)
 	var err error
 
@@ -253,7 +278,7 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if close {
-		if _, err := waitAccountDeleted(ctx, conn, d.Id()); err != nil {
+		if _, err := waitAccountClosed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) delete: %s", d.Id(), err)
 		}
 	}
@@ -261,6 +286,56 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+const accountImportIDSeparator = ":"
+
+func resourceAccountImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	accountID, parentID, err := accountParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := FindAccountByID(ctx, conn, accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading AWS Organizations Account (%s): %w", accountID, err)
+	}
+
+	if status := aws.StringValue(account.Status); status != organizations.AccountStatusActive {
+		return nil, fmt.Errorf("AWS Organizations Account (%s) is %s", accountID, status)
+	}
+
+	if parentID != "" {
+		currentParentID, err := findParentAccountID(ctx, conn, accountID)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading AWS Organizations Account (%s) parent: %w", accountID, err)
+		}
+
+		if currentParentID != parentID {
+			return nil, fmt.Errorf("AWS Organizations Account (%s) parent (%s) does not match (%s)", accountID, currentParentID, parentID)
+		}
+	}
+
+	d.SetId(accountID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// accountParseImportID parses an import ID of the form "account-id" or "account-id:parent-id".
+// The optional parent ID asserts the OU (or root) that the account is expected to be in.
+func accountParseImportID(id string) (string, string, error) {
+	accountID, parentID, found := strings.Cut(id, accountImportIDSeparator)
+
+	if !regexache.MustCompile(`^\d{12}$`).MatchString(accountID) || (found && parentID == "") {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ACCOUNT-ID or ACCOUNT-ID%[2]sPARENT-ID", id, accountImportIDSeparator)
+	}
+
+	return accountID, parentID, nil
+}
+
 func createAccount(ctx context.Context, conn *organizations.Organizations, name, email string, iamUserAccessToBilling, roleName *string, tags []*organizations.Tag, govCloud bool) (*organizations.CreateAccountStatus, error) {
 	if govCloud {
 		input := &organizations.CreateGovCloudAccountInput{
@@ -397,13 +472,13 @@ func statusCreateAccountState(ctx context.Context, conn *organizations.Organizat
 	}
 }
 
-func waitAccountCreated(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.CreateAccountStatus, error) {
+func waitAccountCreated(ctx context.Context, conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.CreateAccountStatus, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      []string{organizations.CreateAccountStateInProgress},
 		Target:       []string{organizations.CreateAccountStateSucceeded},
 		Refresh:      statusCreateAccountState(ctx, conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -421,7 +496,7 @@ func waitAccountCreated(ctx context.Context, conn *organizations.Organizations,
 
 func statusAccountStatus(ctx context.Context, conn *organizations.Organizations, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindAccountByID(ctx, conn, id)
+		output, err := findAccountByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -435,13 +510,13 @@ func statusAccountStatus(ctx context.Context, conn *organizations.Organizations,
 	}
 }
 
-func waitAccountDeleted(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Account, error) {
+func waitAccountClosed(ctx context.Context, conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.Account, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      []string{organizations.AccountStatusPendingClosure, organizations.AccountStatusActive},
-		Target:       []string{},
+		Target:       []string{organizations.AccountStatusSuspended},
 		Refresh:      statusAccountStatus(ctx, conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -452,3 +527,82 @@ func waitAccountDeleted(ctx context.Context, conn *organizations.Organizations,
 
 	return nil, err
 }
+
+func moveAccount(ctx context.Context, conn *organizations.Organizations, accountID, sourceParentID, destinationParentID string) error {
+	var err error
+
+	if strings.HasPrefix(destinationParentID, "r-") {
+		_, err = findRootByID(ctx, conn, destinationParentID)
+	} else {
+		_, err = findOrganizationalUnitByID(ctx, conn, destinationParentID)
+	}
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("destination parent (%s) not found", destinationParentID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading destination parent (%s): %w", destinationParentID, err)
+	}
+
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(accountID),
+		DestinationParentId: aws.String(destinationParentID),
+		SourceParentId:      aws.String(sourceParentID),
+	}
+
+	log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute,
+		func() (interface{}, error) {
+			return conn.MoveAccountWithContext(ctx, input)
+		},
+		organizations.ErrCodeConcurrentModificationException,
+	)
+
+	return err
+}
+
+// findAttachedServiceControlPolicyExemptions returns the customer managed service control policies attached directly to the specified account.
+func findAttachedServiceControlPolicyExemptions(ctx context.Context, conn *organizations.Organizations, accountID string) ([]*organizations.PolicySummary, error) {
+	output, err := findPoliciesForTarget(ctx, conn, accountID, organizations.PolicyTypeServiceControlPolicy)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodePolicyTypeNotEnabledException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.Filter(output, func(v *organizations.PolicySummary) bool {
+		return !aws.BoolValue(v.AwsManaged)
+	}), nil
+}
+
+func createAccountFailureDiagnostic(name string, status *organizations.CreateAccountStatus) diag.Diagnostic {
+	reason := aws.StringValue(status.FailureReason)
+	detail := fmt.Sprintf("Create account request %s failed with reason %s.", aws.StringValue(status.Id), reason)
+
+	switch reason {
+	case organizations.CreateAccountFailureReasonAccountLimitExceeded:
+		detail += " The organization has reached the limit on the number of accounts. Request a quota increase or remove unused accounts."
+	case organizations.CreateAccountFailureReasonConcurrentAccountModification:
+		detail += " Another account creation request was in progress. Retry the operation."
+	case organizations.CreateAccountFailureReasonEmailAlreadyExists:
+		detail += " The email address is already associated with another AWS account."
+	case organizations.CreateAccountFailureReasonGovcloudAccountAlreadyExists:
+		detail += " A GovCloud account is already associated with the account."
+	case organizations.CreateAccountFailureReasonInvalidEmail:
+		detail += " The email address is not valid."
+	case organizations.CreateAccountFailureReasonMissingBusinessValidation, organizations.CreateAccountFailureReasonPendingBusinessValidation, organizations.CreateAccountFailureReasonFailedBusinessValidation:
+		detail += " The management account has not completed business license validation."
+	case organizations.CreateAccountFailureReasonMissingPaymentInstrument, organizations.CreateAccountFailureReasonInvalidPaymentInstrument:
+		detail += " The management account does not have a valid payment method."
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("creating AWS Organizations Account (%s): %s", name, reason),
+		Detail:   detail,
+	}
+}
@@ -9,7 +9,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -28,11 +31,144 @@ func testAccAccountImportStep(n string) resource.TestStep {
 		ImportStateVerifyIgnore: []string{
 			"close_on_deletion",
 			"create_govcloud",
+			"deny_deletion_with_attached_policies",
 			"govcloud_id",
 		},
 	}
 }
 
+func TestAccountParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id               string
+		expectedAccount  string
+		expectedParent   string
+		expectedErrorMsg bool
+	}{
+		"account ID": {
+			id:              "123456789012",
+			expectedAccount: "123456789012",
+		},
+		"account ID and OU ID": {
+			id:              "123456789012:ou-abcd-12345678",
+			expectedAccount: "123456789012",
+			expectedParent:  "ou-abcd-12345678",
+		},
+		"account ID and root ID": {
+			id:              "123456789012:r-abcd",
+			expectedAccount: "123456789012",
+			expectedParent:  "r-abcd",
+		},
+		"empty": {
+			id:               "",
+			expectedErrorMsg: true,
+		},
+		"short account ID": {
+			id:               "12345678901",
+			expectedErrorMsg: true,
+		},
+		"non-numeric account ID": {
+			id:               "12345678901a",
+			expectedErrorMsg: true,
+		},
+		"empty parent ID": {
+			id:               "123456789012:",
+			expectedErrorMsg: true,
+		},
+		"empty account ID": {
+			id:               ":ou-abcd-12345678",
+			expectedErrorMsg: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			accountID, parentID, err := tforganizations.AccountParseImportID(testCase.id)
+
+			if got, want := err != nil, testCase.expectedErrorMsg; got != want {
+				t.Fatalf("AccountParseImportID(%q) err = %v, expected error = %t", testCase.id, err, want)
+			}
+
+			if got, want := accountID, testCase.expectedAccount; got != want {
+				t.Errorf("AccountParseImportID(%q) account ID = %q, want %q", testCase.id, got, want)
+			}
+
+			if got, want := parentID, testCase.expectedParent; got != want {
+				t.Errorf("AccountParseImportID(%q) parent ID = %q, want %q", testCase.id, got, want)
+			}
+		})
+	}
+}
+
+func TestCreateAccountFailureDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		reason         string
+		expectedDetail string
+	}{
+		"account limit exceeded": {
+			reason:         organizations.CreateAccountFailureReasonAccountLimitExceeded,
+			expectedDetail: "Create account request car-1234 failed with reason ACCOUNT_LIMIT_EXCEEDED. The organization has reached the limit on the number of accounts. Request a quota increase or remove unused accounts.",
+		},
+		"concurrent account modification": {
+			reason:         organizations.CreateAccountFailureReasonConcurrentAccountModification,
+			expectedDetail: "Create account request car-1234 failed with reason CONCURRENT_ACCOUNT_MODIFICATION. Another account creation request was in progress. Retry the operation.",
+		},
+		"email already exists": {
+			reason:         organizations.CreateAccountFailureReasonEmailAlreadyExists,
+			expectedDetail: "Create account request car-1234 failed with reason EMAIL_ALREADY_EXISTS. The email address is already associated with another AWS account.",
+		},
+		"invalid email": {
+			reason:         organizations.CreateAccountFailureReasonInvalidEmail,
+			expectedDetail: "Create account request car-1234 failed with reason INVALID_EMAIL. The email address is not valid.",
+		},
+		"pending business validation": {
+			reason:         organizations.CreateAccountFailureReasonPendingBusinessValidation,
+			expectedDetail: "Create account request car-1234 failed with reason PENDING_BUSINESS_VALIDATION. The management account has not completed business license validation.",
+		},
+		"invalid payment instrument": {
+			reason:         organizations.CreateAccountFailureReasonInvalidPaymentInstrument,
+			expectedDetail: "Create account request car-1234 failed with reason INVALID_PAYMENT_INSTRUMENT. The management account does not have a valid payment method.",
+		},
+		"unknown reason": {
+			reason:         "INTERNAL_FAILURE",
+			expectedDetail: "Create account request car-1234 failed with reason INTERNAL_FAILURE.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tforganizations.CreateAccountFailureDiagnostic("test", &organizations.CreateAccountStatus{
+				FailureReason: aws.String(testCase.reason),
+				Id:            aws.String("car-1234"),
+				State:         aws.String(organizations.CreateAccountStateFailed),
+			})
+
+			if got.Severity != diag.Error {
+				t.Errorf("severity = %v, want %v", got.Severity, diag.Error)
+			}
+
+			if want := fmt.Sprintf("creating AWS Organizations Account (test): %s", testCase.reason); got.Summary != want {
+				t.Errorf("summary = %q, want %q", got.Summary, want)
+			}
+
+			if want := testCase.expectedDetail; got.Detail != want {
+				t.Errorf("detail = %q, want %q", got.Detail, want)
+			}
+		})
+	}
+}
+
 func testAccAccount_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
@@ -149,6 +285,28 @@ func testAccAccount_ParentID(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "parent_id", parentIdResourceName2, "id"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAccountImportStateIDFunc(resourceName, parentIdResourceName2),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"close_on_deletion",
+					"create_govcloud",
+					"deny_deletion_with_attached_policies",
+					"govcloud_id",
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAccountImportStateIDFunc(resourceName, parentIdResourceName1),
+				ExpectError:       regexache.MustCompile(`parent \(ou-[0-9a-z-]+\) does not match`),
+			},
+			{
+				Config:      testAccAccountConfig_parentIdNotFound(name, email),
+				ExpectError: regexache.MustCompile(`destination parent \(ou-[0-9a-z-]+\) not found`),
+			},
 		},
 	})
 }
@@ -235,6 +393,60 @@ func testAccAccount_govCloud(t *testing.T) {
 	})
 }
 
+func testAccAccount_denyDeletionWithAttachedPolicies(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
+	orgsEmailDomain := os.Getenv(key)
+	if orgsEmailDomain == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v organizations.Account
+	var policy organizations.Policy
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+	resourceName := "aws_organizations_account.test"
+	policyResourceName := "aws_organizations_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsEnabled(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountConfig_denyDeletionWithAttachedPolicies(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountExists(ctx, resourceName, &v),
+					testAccCheckPolicyExists(ctx, policyResourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "deny_deletion_with_attached_policies", "true"),
+				),
+			},
+			{
+				// The policy attachment is only removed from state, so the account still has the policy attached.
+				Config:      testAccAccountConfig_policyOnly(name),
+				ExpectError: regexache.MustCompile(`account has directly attached service control policies`),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn(ctx)
+
+					_, err := conn.DetachPolicyWithContext(ctx, &organizations.DetachPolicyInput{
+						PolicyId: policy.PolicySummary.Id,
+						TargetId: v.Id,
+					})
+
+					if err != nil {
+						t.Fatalf("detaching AWS Organizations Policy (%s): %s", aws.StringValue(policy.PolicySummary.Id), err)
+					}
+				},
+				Config: testAccAccountConfig_policyOnly(name),
+			},
+		},
+	})
+}
+
 func testAccCheckAccountDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn(ctx)
@@ -286,6 +498,22 @@ func testAccCheckAccountExists(ctx context.Context, n string, v *organizations.A
 	}
 }
 
+func testAccAccountImportStateIDFunc(n, parentResourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		parent, ok := s.RootModule().Resources[parentResourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", parentResourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.ID, parent.Primary.ID), nil
+	}
+}
+
 func testAccAccountConfig_basic(name, email string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_account" "test" {
@@ -351,6 +579,62 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountConfig_parentIdNotFound(name, email string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test1" {
+  name      = "test1"
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_organizational_unit" "test2" {
+  name      = "test2"
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_account" "test" {
+  name              = %[1]q
+  email             = %[2]q
+  parent_id         = replace(aws_organizations_organizational_unit.test2.id, "/-[0-9a-z]+$/", "-00000000")
+  close_on_deletion = true
+}
+`, name, email)
+}
+
+func testAccAccountConfig_policyOnly(name string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_policy" "test" {
+  name    = %[1]q
+  content = jsonencode({
+    Version   = "2012-10-17"
+    Statement = {
+      Effect   = "Deny"
+      Action   = "ec2:TerminateInstances"
+      Resource = "*"
+    }
+  })
+}
+`, name)
+}
+
+func testAccAccountConfig_denyDeletionWithAttachedPolicies(name, email string) string {
+	return acctest.ConfigCompose(testAccAccountConfig_policyOnly(name), fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name                                 = %[1]q
+  email                                = %[2]q
+  close_on_deletion                    = true
+  deny_deletion_with_attached_policies = true
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id    = aws_organizations_policy.test.id
+  target_id    = aws_organizations_account.test.id
+  skip_destroy = true
+}
+`, name, email))
+}
+
 func testAccAccountConfig_tags1(name, email, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_account" "test" {
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_organizations_accounts", name="Accounts")
+func DataSourceAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"joined_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"joined_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ou_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include_descendants": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ou_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	root, err := findDefaultRoot(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations Root: %s", err)
+	}
+
+	ouPath := d.Get("ou_path").(string)
+	parentID, err := findOrganizationalUnitIDByPath(ctx, conn, aws.StringValue(root.Id), ouPath)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations Organizational Unit (%s): %s", ouPath, err)
+	}
+
+	accounts, err := findAccountsWithPathForParent(ctx, conn, parentID, strings.Trim(ouPath, "/"), d.Get("include_descendants").(bool))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Organizations Accounts for parent (%s): %s", parentID, err)
+	}
+
+	if v, ok := d.GetOk(names.AttrTags); ok && len(v.(map[string]interface{})) > 0 {
+		filter := tftags.New(ctx, v.(map[string]interface{}))
+		var filtered []*accountWithPath
+
+		for _, v := range accounts {
+			id := aws.StringValue(v.account.Id)
+			tags, err := listTags(ctx, conn, id)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for Organizations Account (%s): %s", id, err)
+			}
+
+			if tags.ContainsAll(filter) {
+				filtered = append(filtered, v)
+			}
+		}
+
+		accounts = filtered
+	}
+
+	d.SetId(parentID)
+
+	var ids []string
+	for _, v := range accounts {
+		ids = append(ids, aws.StringValue(v.account.Id))
+	}
+
+	if err := d.Set("accounts", flattenAccountsWithPath(accounts)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting accounts: %s", err)
+	}
+	d.Set("ids", ids)
+	d.Set("parent_id", parentID)
+
+	return diags
+}
+
+// accountWithPath is an account together with the location of its parent in the organization.
+type accountWithPath struct {
+	account  *organizations.Account
+	parentID string
+	ouPath   string
+}
+
+// findOrganizationalUnitIDByPath resolves a slash-separated path of organizational unit names, relative to the specified root, to an ID.
+// An empty path resolves to the root.
+func findOrganizationalUnitIDByPath(ctx context.Context, conn *organizations.Organizations, rootID, path string) (string, error) {
+	id := rootID
+
+	for _, name := range splitOrganizationalUnitPath(path) {
+		input := &organizations.ListOrganizationalUnitsForParentInput{
+			ParentId: aws.String(id),
+		}
+
+		ou, err := findOrganizationalUnitForParent(ctx, conn, input, func(v *organizations.OrganizationalUnit) bool {
+			return aws.StringValue(v.Name) == name
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		id = aws.StringValue(ou.Id)
+	}
+
+	return id, nil
+}
+
+func findAccountsWithPathForParent(ctx context.Context, conn *organizations.Organizations, parentID, path string, includeDescendants bool) ([]*accountWithPath, error) {
+	var output []*accountWithPath
+
+	accounts, err := findAccountsForParent(ctx, conn, parentID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range accounts {
+		output = append(output, &accountWithPath{
+			account:  v,
+			parentID: parentID,
+			ouPath:   path,
+		})
+	}
+
+	if !includeDescendants {
+		return output, nil
+	}
+
+	ous, err := findOrganizationalUnitsForParentByID(ctx, conn, parentID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ou := range ous {
+		childPath := aws.StringValue(ou.Name)
+		if path != "" {
+			childPath = path + "/" + childPath
+		}
+
+		accounts, err := findAccountsWithPathForParent(ctx, conn, aws.StringValue(ou.Id), childPath, includeDescendants)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, accounts...)
+	}
+
+	return output, nil
+}
+
+func splitOrganizationalUnitPath(path string) []string {
+	if path = strings.Trim(path, "/"); path == "" {
+		return nil
+	}
+
+	return strings.Split(path, "/")
+}
+
+func flattenAccountsWithPath(apiObjects []*accountWithPath) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":           aws.StringValue(apiObject.account.Arn),
+			"email":         aws.StringValue(apiObject.account.Email),
+			"id":            aws.StringValue(apiObject.account.Id),
+			"joined_method": aws.StringValue(apiObject.account.JoinedMethod),
+			"name":          aws.StringValue(apiObject.account.Name),
+			"ou_path":       apiObject.ouPath,
+			"parent_id":     apiObject.parentID,
+			"status":        aws.StringValue(apiObject.account.Status),
+		}
+
+		if v := apiObject.account.JoinedTimestamp; v != nil {
+			tfMap["joined_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
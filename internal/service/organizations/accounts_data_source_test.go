@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccountsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_accounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "accounts.#", 0),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "ids.#", 0),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parent_id", "data.aws_organizations_organization.current", "roots.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountsDataSource_ouPath(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_organizations_accounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountsDataSourceConfig_ouPath(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parent_id", "aws_organizations_organizational_unit.test1", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAccountsDataSourceConfig_basic = `
+data "aws_organizations_organization" "current" {}
+
+data "aws_organizations_accounts" "test" {
+  include_descendants = true
+}
+`
+
+func testAccAccountsDataSourceConfig_ouPath(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "current" {}
+
+resource "aws_organizations_organizational_unit" "test0" {
+  name      = "%[1]s-0"
+  parent_id = data.aws_organizations_organization.current.roots[0].id
+}
+
+resource "aws_organizations_organizational_unit" "test1" {
+  name      = "%[1]s-1"
+  parent_id = aws_organizations_organizational_unit.test0.id
+}
+
+data "aws_organizations_accounts" "test" {
+  ou_path             = "${aws_organizations_organizational_unit.test0.name}/${aws_organizations_organizational_unit.test1.name}"
+  include_descendants = true
+}
+`, rName)
+}
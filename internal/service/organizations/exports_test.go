@@ -5,6 +5,8 @@ package organizations
 
 // Exports for use in tests only.
 var (
+	AccountParseImportID                   = accountParseImportID
+	CreateAccountFailureDiagnostic         = createAccountFailureDiagnostic
 	FindDelegatedAdministratorByTwoPartKey = findDelegatedAdministratorByTwoPartKey
 	FindOrganizationalUnitByID             = findOrganizationalUnitByID
 	FindPolicyByID                         = findPolicyByID
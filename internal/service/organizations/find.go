@@ -14,6 +14,23 @@ import (
 )
 
 func FindAccountByID(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Account, error) {
+	output, err := findAccountByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.Status); status == organizations.AccountStatusSuspended {
+		return nil, &retry.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return output, nil
+}
+
+// findAccountByID returns the specified account, including suspended (closed) accounts.
+func findAccountByID(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Account, error) {
 	input := &organizations.DescribeAccountInput{
 		AccountId: aws.String(id),
 	}
@@ -35,13 +52,6 @@ func FindAccountByID(ctx context.Context, conn *organizations.Organizations, id
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	if status := aws.StringValue(output.Account.Status); status == organizations.AccountStatusSuspended {
-		return nil, &retry.NotFoundError{
-			Message:     status,
-			LastRequest: input,
-		}
-	}
-
 	return output.Account, nil
 }
 
@@ -82,3 +92,50 @@ func FindPolicyAttachmentByTwoPartKey(ctx context.Context, conn *organizations.O
 
 	return output, nil
 }
+
+func findTargetsForPolicy(ctx context.Context, conn *organizations.Organizations, policyID string) ([]*organizations.PolicyTargetSummary, error) {
+	input := &organizations.ListTargetsForPolicyInput{
+		PolicyId: aws.String(policyID),
+	}
+	var output []*organizations.PolicyTargetSummary
+
+	err := conn.ListTargetsForPolicyPagesWithContext(ctx, input, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Targets...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodePolicyNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findAttachedPoliciesForTarget returns the policies of the specified type that are attached directly to the target.
+func findAttachedPoliciesForTarget(ctx context.Context, conn *organizations.Organizations, targetID, policyType string) ([]*organizations.PolicySummary, error) {
+	output, err := findPoliciesForTarget(ctx, conn, targetID, policyType)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeTargetNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
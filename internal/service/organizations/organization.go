@@ -530,6 +530,18 @@ func findDefaultRoot(ctx context.Context, conn *organizations.Organizations) (*o
 	return output[0], nil
 }
 
+func findRootByID(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Root, error) {
+	output, err := findRoots(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(tfslices.Filter(output, func(v *organizations.Root) bool {
+		return aws.StringValue(v.Id) == id
+	}))
+}
+
 func flattenAccounts(accounts []*organizations.Account) []map[string]interface{} {
 	if len(accounts) == 0 {
 		return nil
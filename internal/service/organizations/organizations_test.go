@@ -43,6 +43,11 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"ParentId":        testAccAccount_ParentID,
 			"Tags":            testAccAccount_Tags,
 			"GovCloud":        testAccAccount_govCloud,
+			"DenyDeletion":    testAccAccount_denyDeletionWithAttachedPolicies,
+		},
+		"AccountsDataSource": {
+			"basic":  testAccAccountsDataSource_basic,
+			"OUPath": testAccAccountsDataSource_ouPath,
 		},
 		"OrganizationalUnit": {
 			"basic":                              testAccOrganizationalUnit_basic,
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -66,11 +67,14 @@ func ResourcePolicy() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Default:      organizations.PolicyTypeServiceControlPolicy,
-				ValidateFunc: validation.StringInSlice(organizations.PolicyType_Values(), false),
+				ValidateFunc: validation.StringInSlice(policyType_Values(), false),
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -135,6 +139,7 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
 
 	if d.HasChangesExcept("tags", "tags_all") {
@@ -154,14 +159,34 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.Name = aws.String(d.Get("name").(string))
 		}
 
-		_, err := conn.UpdatePolicyWithContext(ctx, input)
+		output, err := conn.UpdatePolicyWithContext(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating Organizations policy (%s): %s", d.Id(), err)
 		}
+
+		if d.HasChange("content") {
+			targets, err := findTargetsForPolicy(ctx, conn, d.Id())
+
+			if err != nil {
+				return diag.Errorf("reading Organizations Policy (%s) targets: %s", d.Id(), err)
+			}
+
+			for _, v := range targets {
+				risks, err := policyLockoutRisks(output.Policy, aws.StringValue(v.TargetId), "")
+
+				if err != nil {
+					return diag.Errorf("analyzing Organizations Policy (%s): %s", d.Id(), err)
+				}
+
+				for _, risk := range risks {
+					diags = append(diags, policyLockoutRiskDiagnostic(d.Id(), aws.StringValue(v.TargetId), risk))
+				}
+			}
+		}
 	}
 
-	return resourcePolicyRead(ctx, d, meta)
+	return append(diags, resourcePolicyRead(ctx, d, meta)...)
 }
 
 func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -188,6 +213,18 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourcePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("content") || !isAuthorizationPolicyType(diff.Get("type").(string)) {
+		return nil
+	}
+
+	if _, err := expandAuthorizationPolicyDocument(diff.Get("content").(string)); err != nil {
+		return fmt.Errorf("invalid %s content: %w", diff.Get("type").(string), err)
+	}
+
+	return nil
+}
+
 func resourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
 
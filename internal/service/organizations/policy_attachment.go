@@ -10,11 +10,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -33,6 +35,16 @@ func ResourcePolicyAttachment() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"break_glass_role_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[\w+=,.@-]{1,64}$`), "must be a valid IAM role name"),
+			},
+			"lockout_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"policy_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -48,6 +60,8 @@ func ResourcePolicyAttachment() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: resourcePolicyAttachmentCustomizeDiff,
 	}
 }
 
@@ -58,12 +72,34 @@ func resourcePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 	policyID := d.Get("policy_id").(string)
 	targetID := d.Get("target_id").(string)
 	id := fmt.Sprintf("%s:%s", targetID, policyID)
+
+	// Analyze the policy before attaching it. The policy may not have existed when the plan was made.
+	policy, err := findPolicyByID(ctx, conn, policyID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations Policy (%s): %s", policyID, err)
+	}
+
+	risks, err := policyLockoutRisks(policy, targetID, d.Get("break_glass_role_name").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "analyzing Organizations Policy (%s): %s", policyID, err)
+	}
+
+	if len(risks) > 0 && d.Get("lockout_protection").(bool) {
+		return sdkdiag.AppendErrorf(diags, "attaching Organizations Policy (%s) to target (%s) may lock administrators out: %s", policyID, targetID, strings.Join(risks, "; "))
+	}
+
+	for _, risk := range risks {
+		diags = append(diags, policyLockoutRiskDiagnostic(policyID, targetID, risk))
+	}
+
 	input := &organizations.AttachPolicyInput{
 		PolicyId: aws.String(policyID),
 		TargetId: aws.String(targetID),
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 4*time.Minute, func() (interface{}, error) {
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 4*time.Minute, func() (interface{}, error) {
 		return conn.AttachPolicyWithContext(ctx, input)
 	}, organizations.ErrCodeFinalizingOrganizationException)
 
@@ -140,6 +176,86 @@ func resourcePolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourcePolicyAttachmentCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("break_glass_role_name", "lockout_protection", "policy_id", "target_id") {
+		return nil
+	}
+
+	// The policy or target may be created in the same apply.
+	if !diff.NewValueKnown("policy_id") || !diff.NewValueKnown("target_id") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	policyID := diff.Get("policy_id").(string)
+	targetID := diff.Get("target_id").(string)
+
+	policy, err := findPolicyByID(ctx, conn, policyID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Organizations Policy (%s): %w", policyID, err)
+	}
+
+	policyType := aws.StringValue(policy.PolicySummary.Type)
+
+	if !isAuthorizationPolicyType(policyType) {
+		return nil
+	}
+
+	if diff.Id() == "" || diff.HasChanges("policy_id", "target_id") {
+		attached, err := findAttachedPoliciesForTarget(ctx, conn, targetID, policyType)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading Organizations Policies attached to target (%s): %w", targetID, err)
+		}
+
+		// A replaced attachment is detached before the new one is attached.
+		var replacedPolicyID string
+		if o, _ := diff.GetChange("target_id"); o.(string) == targetID {
+			o, _ := diff.GetChange("policy_id")
+			replacedPolicyID = o.(string)
+		}
+
+		n, alreadyAttached := 0, false
+		for _, v := range attached {
+			switch id := aws.StringValue(v.Id); id {
+			case policyID:
+				alreadyAttached = true
+			case replacedPolicyID:
+			default:
+				n++
+			}
+		}
+
+		if !alreadyAttached && n >= maxAuthorizationPoliciesPerTarget {
+			return fmt.Errorf("target (%s) already has the maximum of %d %s policies attached", targetID, maxAuthorizationPoliciesPerTarget, policyType)
+		}
+	}
+
+	if diff.Get("lockout_protection").(bool) {
+		risks, err := policyLockoutRisks(policy, targetID, diff.Get("break_glass_role_name").(string))
+
+		if err != nil {
+			return fmt.Errorf("analyzing Organizations Policy (%s): %w", policyID, err)
+		}
+
+		if len(risks) > 0 {
+			return fmt.Errorf("attaching Organizations Policy (%s) to target (%s) may lock administrators out: %s", policyID, targetID, strings.Join(risks, "; "))
+		}
+	}
+
+	return nil
+}
+
 func DecodePolicyAttachmentID(id string) (string, string, error) {
 	idParts := strings.Split(id, ":")
 	if len(idParts) != 2 {
@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	// policyTypeResourceControlPolicy is not yet defined by the AWS SDK for Go v1.
+	policyTypeResourceControlPolicy = "RESOURCE_CONTROL_POLICY"
+
+	// maxAuthorizationPoliciesPerTarget is the maximum number of service control (or resource control) policies that can be attached to a root, OU or account.
+	// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html.
+	maxAuthorizationPoliciesPerTarget = 5
+)
+
+func policyType_Values() []string {
+	return append(organizations.PolicyType_Values(), policyTypeResourceControlPolicy)
+}
+
+// isAuthorizationPolicyType returns whether the policy type restricts permissions (SCPs and RCPs).
+func isAuthorizationPolicyType(policyType string) bool {
+	return policyType == organizations.PolicyTypeServiceControlPolicy || policyType == policyTypeResourceControlPolicy
+}
+
+// isRootOrOrganizationalUnitID returns whether the target ID identifies a root or organizational unit, as opposed to an account.
+func isRootOrOrganizationalUnitID(id string) bool {
+	return strings.HasPrefix(id, "r-") || strings.HasPrefix(id, "ou-")
+}
+
+// policyLockoutRisks returns the lockout risks of attaching the policy to the specified target.
+// Only service control and resource control policies attached to a root or organizational unit are analyzed.
+func policyLockoutRisks(policy *organizations.Policy, targetID, breakGlassRoleName string) ([]string, error) {
+	if policy == nil || policy.PolicySummary == nil {
+		return nil, nil
+	}
+
+	if !isAuthorizationPolicyType(aws.StringValue(policy.PolicySummary.Type)) || !isRootOrOrganizationalUnitID(targetID) {
+		return nil, nil
+	}
+
+	doc, err := expandAuthorizationPolicyDocument(aws.StringValue(policy.Content))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.lockoutRisks(breakGlassRoleName), nil
+}
+
+func policyLockoutRiskDiagnostic(policyID, targetID, risk string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Organizations Policy (%s) may lock administrators out of target (%s)", policyID, targetID),
+		Detail:   fmt.Sprintf("%s. Every account under the target is affected.", risk),
+	}
+}
+
+// authorizationPolicyDocument is the subset of the SCP/RCP grammar needed for lockout analysis.
+type authorizationPolicyDocument struct {
+	Version    string                           `json:",omitempty"`
+	Statements authorizationPolicyStatementList `json:"Statement"`
+}
+
+type authorizationPolicyStatement struct {
+	Sid          string                 `json:",omitempty"`
+	Effect       string                 `json:",omitempty"`
+	Actions      policyStringList       `json:"Action,omitempty"`
+	NotActions   policyStringList       `json:"NotAction,omitempty"`
+	Resources    policyStringList       `json:"Resource,omitempty"`
+	NotResources policyStringList       `json:"NotResource,omitempty"`
+	Conditions   map[string]interface{} `json:"Condition,omitempty"`
+}
+
+// authorizationPolicyStatementList accepts either a single statement or a list of statements.
+type authorizationPolicyStatementList []*authorizationPolicyStatement
+
+func (l *authorizationPolicyStatementList) UnmarshalJSON(b []byte) error {
+	var statements []*authorizationPolicyStatement
+
+	if err := json.Unmarshal(b, &statements); err == nil {
+		*l = statements
+		return nil
+	}
+
+	var statement authorizationPolicyStatement
+
+	if err := json.Unmarshal(b, &statement); err != nil {
+		return err
+	}
+
+	*l = authorizationPolicyStatementList{&statement}
+
+	return nil
+}
+
+// policyStringList accepts either a single string or a list of strings.
+type policyStringList []string
+
+func (l *policyStringList) UnmarshalJSON(b []byte) error {
+	var values []string
+
+	if err := json.Unmarshal(b, &values); err == nil {
+		*l = values
+		return nil
+	}
+
+	var value string
+
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+
+	*l = policyStringList{value}
+
+	return nil
+}
+
+func expandAuthorizationPolicyDocument(content string) (*authorizationPolicyDocument, error) {
+	var doc authorizationPolicyDocument
+
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("parsing policy content: %w", err)
+	}
+
+	if len(doc.Statements) == 0 {
+		return nil, errors.New("policy content must contain at least one Statement")
+	}
+
+	var errs []error
+
+	for i, v := range doc.Statements {
+		if v.Effect != "Allow" && v.Effect != "Deny" {
+			errs = append(errs, fmt.Errorf("Statement[%d]: Effect must be Allow or Deny, got %q", i, v.Effect))
+		}
+
+		if len(v.Actions) > 0 && len(v.NotActions) > 0 {
+			errs = append(errs, fmt.Errorf("Statement[%d]: only one of Action or NotAction can be specified", i))
+		} else if len(v.Actions) == 0 && len(v.NotActions) == 0 {
+			errs = append(errs, fmt.Errorf("Statement[%d]: one of Action or NotAction must be specified", i))
+		}
+
+		if len(v.Resources) > 0 && len(v.NotResources) > 0 {
+			errs = append(errs, fmt.Errorf("Statement[%d]: only one of Resource or NotResource can be specified", i))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// lockoutRisks returns a description of each statement in the policy that could lock administrators out of the accounts that it applies to.
+// A Deny statement is a risk if it covers all AWS Organizations actions, or sts:AssumeRole on the break-glass role (if specified),
+// unless it has a Condition that excludes the break-glass role by principal ARN or name.
+func (doc *authorizationPolicyDocument) lockoutRisks(breakGlassRoleName string) []string {
+	var risks []string
+
+	for i, v := range doc.Statements {
+		if v.Effect != "Deny" || v.exempts(breakGlassRoleName) {
+			continue
+		}
+
+		id := fmt.Sprintf("Statement[%d]", i)
+		if v.Sid != "" {
+			id = fmt.Sprintf("Statement %q", v.Sid)
+		}
+
+		if v.coversAction("organizations:*") && v.coversResource("*") {
+			risks = append(risks, fmt.Sprintf("%s denies organizations:*", id))
+		}
+
+		if breakGlassRoleName != "" && v.coversAction("sts:AssumeRole") && v.coversRole(breakGlassRoleName) {
+			risks = append(risks, fmt.Sprintf("%s denies sts:AssumeRole for break-glass role %s", id, breakGlassRoleName))
+		}
+	}
+
+	return risks
+}
+
+// exempts returns whether the statement has a negated condition on the principal ARN or name that excludes the specified role.
+// Other conditions cannot be analyzed, so they never exempt a statement.
+func (s *authorizationPolicyStatement) exempts(roleName string) bool {
+	if roleName == "" {
+		return false
+	}
+
+	for operator, v := range s.Conditions {
+		operator = strings.TrimPrefix(strings.TrimPrefix(operator, "ForAnyValue:"), "ForAllValues:")
+		operator = strings.TrimSuffix(operator, "IfExists")
+
+		var wildcards bool
+		switch operator {
+		case "ArnNotLike", "StringNotLike":
+			wildcards = true
+		case "ArnNotEquals", "StringNotEquals", "StringNotEqualsIgnoreCase":
+		default:
+			continue
+		}
+
+		block, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for key, v := range block {
+			var values policyStringList
+			if b, err := json.Marshal(v); err != nil || json.Unmarshal(b, &values) != nil {
+				continue
+			}
+
+			for _, value := range values {
+				switch strings.ToLower(key) {
+				case "aws:principalarn":
+					if conditionValueMatchesRoleARN(value, roleName, wildcards) {
+						return true
+					}
+				case "aws:principalname":
+					if conditionValueMatches(value, roleName, wildcards) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// conditionValueMatchesRoleARN returns whether the condition value matches the ARN of the IAM role with the specified name in any account.
+func conditionValueMatchesRoleARN(value, roleName string, wildcards bool) bool {
+	_, rolePath, ok := strings.Cut(value, ":role/")
+
+	if !ok {
+		return false
+	}
+
+	if !wildcards {
+		// Without wildcards the account ID must be specified, and the role name is the last element of the role path.
+		if strings.ContainsAny(value, "*?") {
+			return false
+		}
+
+		return strings.EqualFold(rolePath[strings.LastIndex(rolePath, "/")+1:], roleName)
+	}
+
+	return policyPatternMatch(rolePath, roleName) || policyPatternMatch(rolePath, "*/"+roleName)
+}
+
+func conditionValueMatches(value, roleName string, wildcards bool) bool {
+	if !wildcards {
+		return strings.EqualFold(value, roleName)
+	}
+
+	return policyPatternMatch(value, roleName)
+}
+
+// coversAction returns whether the statement applies to the specified action.
+// An action ending in "*" is covered only if every action it represents is covered.
+func (s *authorizationPolicyStatement) coversAction(action string) bool {
+	if len(s.NotActions) > 0 {
+		for _, v := range s.NotActions {
+			if policyPatternMatch(v, action) || policyPatternMatch(action, v) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, v := range s.Actions {
+		if policyPatternMatch(v, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *authorizationPolicyStatement) coversResource(resource string) bool {
+	if len(s.NotResources) > 0 {
+		for _, v := range s.NotResources {
+			if policyPatternMatch(v, resource) || policyPatternMatch(resource, v) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// SCPs that omit Resource apply to all resources.
+	if len(s.Resources) == 0 {
+		return true
+	}
+
+	for _, v := range s.Resources {
+		if policyPatternMatch(v, resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// coversRole returns whether the statement applies to the IAM role with the specified name in any account.
+func (s *authorizationPolicyStatement) coversRole(roleName string) bool {
+	matches := func(pattern string) bool {
+		if pattern == "*" {
+			return true
+		}
+
+		_, rolePath, ok := strings.Cut(pattern, ":role/")
+
+		if !ok {
+			return false
+		}
+
+		// The role name is the last element of the role path.
+		return policyPatternMatch(rolePath, roleName) || policyPatternMatch(rolePath, "*/"+roleName)
+	}
+
+	if len(s.NotResources) > 0 {
+		for _, v := range s.NotResources {
+			if matches(v) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if len(s.Resources) == 0 {
+		return true
+	}
+
+	for _, v := range s.Resources {
+		if matches(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyPatternMatch reports whether value matches the case-insensitive policy pattern, which may contain '*' and '?' wildcards.
+func policyPatternMatch(pattern, value string) bool {
+	pattern, value = strings.ToLower(pattern), strings.ToLower(value)
+	p, v := 0, 0
+	star, match := -1, 0
+
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, v
+			p++
+		case star != -1:
+			p = star + 1
+			match++
+			v = match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
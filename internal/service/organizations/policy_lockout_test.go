@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandAuthorizationPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content       string
+		expectedError bool
+	}{
+		"invalid JSON": {
+			content:       `{`,
+			expectedError: true,
+		},
+		"no statements": {
+			content:       `{"Version": "2012-10-17"}`,
+			expectedError: true,
+		},
+		"single statement": {
+			content: `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+		},
+		"invalid effect": {
+			content:       `{"Statement": [{"Effect": "Permit", "Action": "*", "Resource": "*"}]}`,
+			expectedError: true,
+		},
+		"action and not action": {
+			content:       `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "NotAction": "iam:*", "Resource": "*"}]}`,
+			expectedError: true,
+		},
+		"no action": {
+			content:       `{"Statement": [{"Effect": "Deny", "Resource": "*"}]}`,
+			expectedError: true,
+		},
+		"resource and not resource": {
+			content:       `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*", "NotResource": "arn:aws:s3:::example"}]}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := expandAuthorizationPolicyDocument(testCase.content)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("expandAuthorizationPolicyDocument() err = %v, expected error = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestAuthorizationPolicyDocumentLockoutRisks(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content            string
+		breakGlassRoleName string
+		expected           []string
+	}{
+		"full access": {
+			content: `{"Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+		},
+		"deny all": {
+			content:  `{"Statement": [{"Effect": "Deny", "Action": "*", "Resource": "*"}]}`,
+			expected: []string{"Statement[0] denies organizations:*"},
+		},
+		"deny organizations": {
+			content:  `{"Statement": [{"Sid": "DenyOrgs", "Effect": "Deny", "Action": "organizations:*", "Resource": "*"}]}`,
+			expected: []string{`Statement "DenyOrgs" denies organizations:*`},
+		},
+		"deny leave organization": {
+			content: `{"Statement": [{"Effect": "Deny", "Action": "organizations:LeaveOrganization", "Resource": "*"}]}`,
+		},
+		"deny not action excluding organizations": {
+			content: `{"Statement": [{"Effect": "Deny", "NotAction": ["iam:*", "organizations:*", "sts:*"], "Resource": "*"}]}`,
+		},
+		"deny not action including organizations": {
+			content:            `{"Statement": [{"Effect": "Deny", "NotAction": ["iam:*"], "Resource": "*"}]}`,
+			breakGlassRoleName: "BreakGlass",
+			expected: []string{
+				"Statement[0] denies organizations:*",
+				"Statement[0] denies sts:AssumeRole for break-glass role BreakGlass",
+			},
+		},
+		"conditional deny without break-glass role": {
+			content:  `{"Statement": [{"Effect": "Deny", "Action": "organizations:*", "Resource": "*", "Condition": {"StringNotEquals": {"aws:RequestedRegion": "us-east-1"}}}]}`,
+			expected: []string{"Statement[0] denies organizations:*"},
+		},
+		"conditional deny exempting principal without break-glass role": {
+			content:  `{"Statement": [{"Effect": "Deny", "Action": "organizations:*", "Resource": "*", "Condition": {"ArnNotLike": {"aws:PrincipalArn": "arn:aws:iam::*:role/BreakGlass"}}}]}`,
+			expected: []string{"Statement[0] denies organizations:*"},
+		},
+		"conditional deny with unrelated condition referencing break-glass role": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "organizations:*", "Resource": "*", "Condition": {"StringNotEquals": {"aws:ResourceTag/Owner": "BreakGlass"}}}]}`,
+			breakGlassRoleName: "BreakGlass",
+			expected:           []string{"Statement[0] denies organizations:*"},
+		},
+		"conditional deny including break-glass role": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "organizations:*", "Resource": "*", "Condition": {"ArnLike": {"aws:PrincipalArn": "arn:aws:iam::*:role/BreakGlass"}}}]}`,
+			breakGlassRoleName: "BreakGlass",
+			expected:           []string{"Statement[0] denies organizations:*"},
+		},
+		"conditional deny exempting other role": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "organizations:*", "Resource": "*", "Condition": {"ArnNotLike": {"aws:PrincipalArn": "arn:aws:iam::*:role/BreakGlassReadOnly"}}}]}`,
+			breakGlassRoleName: "BreakGlass",
+			expected:           []string{"Statement[0] denies organizations:*"},
+		},
+		"conditional deny exempting break-glass role by exact ARN": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "organizations:*", "Resource": "*", "Condition": {"StringNotEquals": {"aws:PrincipalARN": ["arn:aws:iam::123456789012:role/Admin", "arn:aws:iam::123456789012:role/emergency/BreakGlass"]}}}]}`,
+			breakGlassRoleName: "BreakGlass",
+		},
+		"conditional deny exempting break-glass role by ARN with literal wildcard": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "organizations:*", "Resource": "*", "Condition": {"StringNotEquals": {"aws:PrincipalArn": "arn:aws:iam::*:role/BreakGlass"}}}]}`,
+			breakGlassRoleName: "BreakGlass",
+			expected:           []string{"Statement[0] denies organizations:*"},
+		},
+		"conditional deny exempting break-glass role by name": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "organizations:*", "Resource": "*", "Condition": {"StringNotLikeIfExists": {"aws:PrincipalName": "Break*"}}}]}`,
+			breakGlassRoleName: "BreakGlass",
+		},
+		"conditional deny with break-glass role": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "sts:AssumeRole", "Resource": "*", "Condition": {"StringNotEquals": {"aws:RequestedRegion": "us-east-1"}}}]}`,
+			breakGlassRoleName: "BreakGlass",
+			expected:           []string{"Statement[0] denies sts:AssumeRole for break-glass role BreakGlass"},
+		},
+		"conditional deny exempting break-glass role": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "*", "Resource": "*", "Condition": {"ArnNotLike": {"aws:PrincipalArn": "arn:aws:iam::*:role/BreakGlass"}}}]}`,
+			breakGlassRoleName: "BreakGlass",
+		},
+		"deny assume role on other role": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "sts:AssumeRole", "Resource": "arn:aws:iam::*:role/Other"}]}`,
+			breakGlassRoleName: "BreakGlass",
+		},
+		"deny assume role on role wildcard": {
+			content:            `{"Statement": [{"Effect": "Deny", "Action": "sts:Assume*", "Resource": "arn:aws:iam::*:role/Break*"}]}`,
+			breakGlassRoleName: "BreakGlass",
+			expected:           []string{"Statement[0] denies sts:AssumeRole for break-glass role BreakGlass"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := expandAuthorizationPolicyDocument(testCase.content)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(doc.lockoutRisks(testCase.breakGlassRoleName), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyPatternMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{"*", "organizations:*", true},
+		{"organizations:*", "organizations:*", true},
+		{"Organizations:*", "organizations:CreateAccount", true},
+		{"organizations:Create*", "organizations:*", false},
+		{"sts:Assume?ole", "sts:AssumeRole", true},
+		{"sts:AssumeRole", "sts:AssumeRoleWithSAML", false},
+		{"", "", true},
+		{"", "a", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := policyPatternMatch(testCase.pattern, testCase.value), testCase.expected; got != want {
+			t.Errorf("policyPatternMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.value, got, want)
+		}
+	}
+}
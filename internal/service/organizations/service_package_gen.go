@@ -25,6 +25,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAccounts,
+			TypeName: "aws_organizations_accounts",
+			Name:     "Accounts",
+		},
 		{
 			Factory:  DataSourceDelegatedAdministrators,
 			TypeName: "aws_organizations_delegated_administrators",
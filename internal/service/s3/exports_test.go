@@ -27,6 +27,7 @@ var (
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
+	ResourceObjectsSync                             = resourceObjectsSync
 
 	BucketListTags                        = bucketListTags
 	BucketUpdateTags                      = bucketUpdateTags
@@ -51,6 +52,8 @@ var (
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
 	FindObjectLockConfiguration           = findObjectLockConfiguration
+	FileChecksum                          = fileChecksum
+	FileCompositeChecksum                 = fileCompositeChecksum
 	FindOwnershipControls                 = findOwnershipControls
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
 	FindReplicationConfiguration          = findReplicationConfiguration
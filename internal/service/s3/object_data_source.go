@@ -5,8 +5,19 @@ package s3
 
 import (
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,12 +29,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
 )
 
 // @SDKDataSource("aws_s3_object", name="Object")
@@ -130,6 +144,18 @@ func dataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"output_base64sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"range": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -182,6 +208,11 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if v, ok := d.GetOk("checksum_mode"); ok {
 		input.ChecksumMode = types.ChecksumMode(v.(string))
 	}
+	// The stored checksum is needed to verify a downloaded file.
+	outputPath := d.Get("output_path").(string)
+	if outputPath != "" {
+		input.ChecksumMode = types.ChecksumModeEnabled
+	}
 	if v, ok := d.GetOk("range"); ok {
 		input.Range = aws.String(v.(string))
 	}
@@ -250,7 +281,31 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("version_id", output.VersionId)
 	d.Set("website_redirect_location", output.WebsiteRedirectLocation)
 
-	if isContentTypeAllowed(output.ContentType) {
+	if outputPath != "" {
+		input := &s3.GetObjectInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: output.VersionId,
+		}
+		if v, ok := d.GetOk("range"); ok {
+			input.Range = aws.String(v.(string))
+		}
+
+		path, err := downloadObjectToFile(ctx, conn, input, output, outputPath, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "downloading S3 Bucket (%s) Object (%s) to %s: %s", bucket, key, outputPath, err)
+		}
+
+		digest, err := fileDigest(path, sha256.New())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "hashing %s: %s", path, err)
+		}
+
+		d.Set("output_base64sha256", base64.StdEncoding.EncodeToString(digest))
+		d.Set("output_sha256", hex.EncodeToString(digest))
+	} else if isContentTypeAllowed(output.ContentType) {
 		downloader := manager.NewDownloader(conn, manager.WithDownloaderClientOptions(optFns...))
 		buf := manager.NewWriteAtBuffer(make([]byte, 0))
 		input := &s3.GetObjectInput{
@@ -308,3 +363,232 @@ func isContentTypeAllowed(contentType *string) bool {
 
 	return false
 }
+
+// downloadObjectToFile downloads the specified object to a local file using parallel ranged GETs and returns the file's expanded path.
+// If the object has a stored checksum the downloaded content is verified against it before the file is written.
+// An existing file whose content already matches the stored checksum is not downloaded again.
+func downloadObjectToFile(ctx context.Context, conn *s3.Client, input *s3.GetObjectInput, head *s3.HeadObjectOutput, outputPath string, optFns ...func(*s3.Options)) (string, error) {
+	path, err := homedir.Expand(outputPath)
+	if err != nil {
+		return "", fmt.Errorf("expanding homedir: %w", err)
+	}
+
+	// A partial object can't be verified against the checksum of the whole object.
+	algorithm, checksum := objectChecksum(head)
+	verify := checksum != "" && input.Range == nil
+
+	if verify {
+		if _, err := os.Stat(path); err == nil {
+			if err := verifyObjectFileChecksum(ctx, conn, input, algorithm, checksum, path, optFns...); err == nil {
+				return path, nil
+			}
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return "", err
+	}
+	tempPath := file.Name()
+	defer os.Remove(tempPath)
+
+	downloader := manager.NewDownloader(conn, manager.WithDownloaderClientOptions(optFns...))
+	_, err = downloader.Download(ctx, file, input)
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if verify {
+		if err := verifyObjectFileChecksum(ctx, conn, input, algorithm, checksum, tempPath, optFns...); err != nil {
+			return "", err
+		}
+	}
+
+	if err := os.Rename(tempPath, path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// objectChecksum returns the algorithm and value of the checksum stored with an object, if any.
+func objectChecksum(output *s3.HeadObjectOutput) (types.ChecksumAlgorithm, string) {
+	switch {
+	case output.ChecksumCRC32 != nil:
+		return types.ChecksumAlgorithmCrc32, aws.ToString(output.ChecksumCRC32)
+	case output.ChecksumCRC32C != nil:
+		return types.ChecksumAlgorithmCrc32c, aws.ToString(output.ChecksumCRC32C)
+	case output.ChecksumSHA1 != nil:
+		return types.ChecksumAlgorithmSha1, aws.ToString(output.ChecksumSHA1)
+	case output.ChecksumSHA256 != nil:
+		return types.ChecksumAlgorithmSha256, aws.ToString(output.ChecksumSHA256)
+	}
+
+	return "", ""
+}
+
+func newChecksumHash(algorithm types.ChecksumAlgorithm) (hash.Hash, error) {
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case types.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case types.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case types.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+}
+
+// verifyObjectFileChecksum verifies a local file's content against an object's stored checksum.
+// Objects uploaded in parts have a checksum of their parts' checksums, suffixed with the number of parts.
+func verifyObjectFileChecksum(ctx context.Context, conn *s3.Client, input *s3.GetObjectInput, algorithm types.ChecksumAlgorithm, want, path string, optFns ...func(*s3.Options)) error {
+	var got string
+	var err error
+
+	if _, v, ok := strings.Cut(want, "-"); ok {
+		partCount, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parsing %s checksum (%s): %w", algorithm, want, err)
+		}
+
+		parts, err := findObjectPartsByBucketAndKey(ctx, conn, aws.ToString(input.Bucket), aws.ToString(input.Key), aws.ToString(input.VersionId), optFns...)
+		if err != nil {
+			return fmt.Errorf("reading object parts: %w", err)
+		}
+
+		if len(parts) != partCount {
+			return fmt.Errorf("%s checksum (%s) covers %d parts, object has %d", algorithm, want, partCount, len(parts))
+		}
+
+		got, err = fileCompositeChecksum(path, algorithm, tfslices.ApplyToAll(parts, func(v types.ObjectPart) int64 {
+			return aws.ToInt64(v.Size)
+		}))
+		if err != nil {
+			return err
+		}
+	} else {
+		got, err = fileChecksum(path, algorithm)
+		if err != nil {
+			return err
+		}
+	}
+
+	if got != want {
+		return fmt.Errorf("%s checksum mismatch: downloaded content has %s, object has %s", algorithm, got, want)
+	}
+
+	return nil
+}
+
+// fileChecksum returns the base64-encoded checksum of a file's content.
+func fileChecksum(path string, algorithm types.ChecksumAlgorithm) (string, error) {
+	h, err := newChecksumHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	digest, err := fileDigest(path, h)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(digest), nil
+}
+
+// fileCompositeChecksum returns the base64-encoded checksum of the checksums of a file's consecutive parts of the specified sizes,
+// suffixed with the number of parts.
+func fileCompositeChecksum(path string, algorithm types.ChecksumAlgorithm, partSizes []int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	composite, err := newChecksumHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	for i, size := range partSizes {
+		h, _ := newChecksumHash(algorithm)
+
+		if _, err := io.CopyN(h, file, size); err != nil {
+			return "", fmt.Errorf("reading part %d: %w", i+1, err)
+		}
+
+		composite.Write(h.Sum(nil))
+	}
+
+	if n, _ := io.Copy(io.Discard, file); n > 0 {
+		return "", fmt.Errorf("file is %d bytes larger than the object's parts", n)
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(composite.Sum(nil)), len(partSizes)), nil
+}
+
+func fileDigest(path string, h hash.Hash) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+func findObjectPartsByBucketAndKey(ctx context.Context, conn *s3.Client, bucket, key, versionID string, optFns ...func(*s3.Options)) ([]types.ObjectPart, error) {
+	input := &s3.GetObjectAttributesInput{
+		Bucket:           aws.String(bucket),
+		Key:              aws.String(key),
+		ObjectAttributes: []types.ObjectAttributes{types.ObjectAttributesObjectParts},
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+	var output []types.ObjectPart
+
+	for {
+		page, err := conn.GetObjectAttributes(ctx, input, optFns...)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchKey) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil || page.ObjectParts == nil {
+			break
+		}
+
+		output = append(output, page.ObjectParts.Parts...)
+
+		if !aws.ToBool(page.ObjectParts.IsTruncated) {
+			break
+		}
+
+		input.PartNumberMarker = page.ObjectParts.NextPartNumberMarker
+	}
+
+	return output, nil
+}
@@ -5,13 +5,18 @@ package s3_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -487,6 +492,81 @@ func TestAccS3ObjectDataSource_checksumMode(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_outputPath(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+	outputPath := filepath.Join(t.TempDir(), "nested", "object.bin")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_outputPath(rName, outputPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "body", ""),
+					resource.TestCheckResourceAttrSet(dataSourceName, "checksum_sha256"),
+					resource.TestCheckResourceAttr(dataSourceName, "output_base64sha256", "OrrJtFM3CCTTrJlo1wb1pthV925LBmd8nQecthtbEos="),
+					resource.TestCheckResourceAttr(dataSourceName, "output_path", outputPath),
+					resource.TestCheckResourceAttr(dataSourceName, "output_sha256", "3abac9b453370824d3ac9968d706f5a6d855f76e4b06677c9d079cb61b5b128b"),
+					testAccCheckObjectDataSourceOutputFile(outputPath, "Keep Calm and Carry On"),
+				),
+			},
+		},
+	})
+}
+
+func TestObjectFileChecksums(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "object")
+	if err := os.WriteFile(path, []byte("hello world"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		algorithm types.ChecksumAlgorithm
+		partSizes []int64
+		want      string
+		wantErr   bool
+	}{
+		{algorithm: types.ChecksumAlgorithmCrc32, want: "DUoRhQ=="},
+		{algorithm: types.ChecksumAlgorithmCrc32c, want: "yZRlqg=="},
+		{algorithm: types.ChecksumAlgorithmSha1, want: "Kq5sNclPz7QV2+lfQIuc6R7oRu0="},
+		{algorithm: types.ChecksumAlgorithmSha256, want: "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="},
+		{algorithm: types.ChecksumAlgorithmCrc32, partSizes: []int64{6, 5}, want: "1Fu2mQ==-2"},
+		{algorithm: types.ChecksumAlgorithmSha256, partSizes: []int64{6, 5}, want: "Zhie15keHg/OBlOZxcoF/BXCgYZaeimRvdZnwUZqkaQ=-2"},
+		{algorithm: types.ChecksumAlgorithmSha256, partSizes: []int64{6, 6}, wantErr: true},
+		{algorithm: types.ChecksumAlgorithmSha256, partSizes: []int64{6, 4}, wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s %v", testCase.algorithm, testCase.partSizes), func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			var err error
+			if testCase.partSizes == nil {
+				got, err = tfs3.FileChecksum(path, testCase.algorithm)
+			} else {
+				got, err = tfs3.FileCompositeChecksum(path, testCase.algorithm, testCase.partSizes)
+			}
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if got != testCase.want {
+				t.Errorf("checksum = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccS3ObjectDataSource_metadata(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -582,6 +662,21 @@ func TestAccS3ObjectDataSource_directoryBucket(t *testing.T) {
 	})
 }
 
+func testAccCheckObjectDataSourceOutputFile(path, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if got, want := string(b), content; got != want {
+			return fmt.Errorf("%s content = %q, want %q", path, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccObjectDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -979,6 +1074,29 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_outputPath(rName, outputPath string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = "Keep Calm and Carry On"
+  content_type = "application/octet-stream"
+
+  checksum_algorithm = "SHA256"
+}
+
+data "aws_s3_object" "test" {
+  bucket      = aws_s3_bucket.test.bucket
+  key         = aws_s3_object.test.key
+  output_path = %[2]q
+}
+`, rName, outputPath)
+}
+
 func testAccObjectDataSourceConfig_metadata(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -0,0 +1,757 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"maps"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/glob"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	objectsSyncResourceIDPartCount = 2
+
+	// Maximum number of keys in a single DeleteObjects request.
+	deleteObjectsMaxKeys = 1000
+)
+
+// @SDKResource("aws_s3_objects_sync", name="Objects Sync")
+func resourceObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectsSyncCreate,
+		ReadWithoutTimeout:   resourceObjectsSyncRead,
+		UpdateWithoutTimeout: resourceObjectsSyncUpdate,
+		DeleteWithoutTimeout: resourceObjectsSyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectCannedACL](),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"delete_unmanaged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateObjectsSyncGlob,
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+				ValidateFunc: validation.All(
+					validation.StringDoesNotMatch(regexache.MustCompile(`^/`), "must not begin with a forward slash (/)"),
+					validation.StringMatch(regexache.MustCompile(`(^|/)$`), "must end with a forward slash (/)"),
+				),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_language": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"glob": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateObjectsSyncGlob,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_class": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.StorageClass](),
+			},
+		},
+	}
+}
+
+func resourceObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, optFns := objectsSyncClient(ctx, meta, d.Get("bucket").(string))
+
+	bucket, keyPrefix := d.Get("bucket").(string), d.Get("key_prefix").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{bucket, keyPrefix}, objectsSyncResourceIDPartCount, true))
+
+	files, err := objectsSyncSourceFiles(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	etags, err := uploadObjectsSyncFiles(ctx, conn, d, files, optFns...)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Objects Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("etags", etags)
+	d.Set("files", objectsSyncFileHashes(files))
+
+	if d.Get("delete_unmanaged").(bool) {
+		if err := deleteUnmanagedObjects(ctx, conn, bucket, keyPrefix, files, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Objects Sync (%s): %s", id, err)
+		}
+	}
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parts, err := flex.ExpandResourceId(d.Id(), objectsSyncResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	bucket, keyPrefix := parts[0], parts[1]
+	conn, optFns := objectsSyncClient(ctx, meta, bucket)
+
+	objects, err := findObjectsByBucketAndPrefix(ctx, conn, bucket, keyPrefix, optFns...)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Objects Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	files := flex.ExpandStringValueMap(d.Get("files").(map[string]interface{}))
+	etags := flex.ExpandStringValueMap(d.Get("etags").(map[string]interface{}))
+
+	// Objects that are missing or were modified outside Terraform are dropped from state so that they are uploaded again.
+	for key := range files {
+		if object, ok := objects[key]; !ok || normalizeETag(aws.ToString(object.ETag)) != normalizeETag(etags[key]) {
+			delete(files, key)
+			delete(etags, key)
+		}
+	}
+
+	// Unmanaged objects are added to state with an empty hash so that they are deleted.
+	if d.Get("delete_unmanaged").(bool) {
+		for key := range objects {
+			if _, ok := files[key]; !ok {
+				files[key] = ""
+			}
+		}
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("etags", etags)
+	d.Set("files", files)
+	d.Set("key_prefix", keyPrefix)
+
+	return diags
+}
+
+func resourceObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, optFns := objectsSyncClient(ctx, meta, d.Get("bucket").(string))
+
+	bucket, keyPrefix := d.Get("bucket").(string), d.Get("key_prefix").(string)
+
+	files, err := objectsSyncSourceFiles(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	o, _ := d.GetChange("files")
+	old := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	etags := flex.ExpandStringValueMap(d.Get("etags").(map[string]interface{}))
+	// Changes to object properties require every object to be uploaded again.
+	all := d.HasChanges("acl", "kms_key_id", "rule", "server_side_encryption", "storage_class")
+
+	uploads := tfslices.Filter(files, func(v objectsSyncFile) bool {
+		return all || old[v.key] != v.hash
+	})
+
+	uploaded, err := uploadObjectsSyncFiles(ctx, conn, d, uploads, optFns...)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	maps.Copy(etags, uploaded)
+
+	want := objectsSyncFileHashes(files)
+	var deletes []string
+	for key := range old {
+		if _, ok := want[key]; !ok {
+			deletes = append(deletes, key)
+			delete(etags, key)
+		}
+	}
+
+	if err := deleteObjectsByKeys(ctx, conn, bucket, deletes, optFns...); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	d.Set("etags", etags)
+	d.Set("files", want)
+
+	if d.HasChange("delete_unmanaged") && d.Get("delete_unmanaged").(bool) {
+		if err := deleteUnmanagedObjects(ctx, conn, bucket, keyPrefix, files, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	conn, optFns := objectsSyncClient(ctx, meta, bucket)
+
+	log.Printf("[DEBUG] Deleting S3 Objects Sync: %s", d.Id())
+	keys := tfmaps.Keys(d.Get("files").(map[string]interface{}))
+	err := deleteObjectsByKeys(ctx, conn, bucket, keys, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("exclude") || !d.NewValueKnown("key_prefix") {
+		if err := d.SetNewComputed("files"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("etags")
+	}
+
+	files, err := objectsSyncSourceFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	o := flex.ExpandStringValueMap(d.Get("files").(map[string]interface{}))
+	n := objectsSyncFileHashes(files)
+
+	if !maps.Equal(o, n) {
+		if err := d.SetNew("files", n); err != nil {
+			return err
+		}
+	}
+
+	if !maps.Equal(o, n) || d.HasChanges("acl", "kms_key_id", "rule", "server_side_encryption", "storage_class") {
+		return d.SetNewComputed("etags")
+	}
+
+	return nil
+}
+
+// objectsSyncClient returns the S3 client and per-operation options for the specified bucket.
+func objectsSyncClient(ctx context.Context, meta interface{}, bucket string) (*s3.Client, []func(*s3.Options)) {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	return conn, optFns
+}
+
+type objectsSyncFile struct {
+	hash string // Hex-encoded SHA-256 hash of the file's content.
+	key  string // S3 object key.
+	path string // Local file path.
+	rel  string // Path relative to the source directory, using forward slashes.
+}
+
+// objectsSyncSourceFiles walks the configured source directory, returning every file not matched by an exclude glob.
+func objectsSyncSourceFiles(d verify.ResourceDiffer) ([]objectsSyncFile, error) {
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", d.Get("source_dir").(string), err)
+	}
+
+	excludes := flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set))
+
+	keyPrefix := d.Get("key_prefix").(string)
+	var files []objectsSyncFile
+
+	err = filepath.WalkDir(sourceDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range excludes {
+			if glob.MatchPath(pattern, rel) {
+				return nil
+			}
+		}
+
+		hash, err := fileSHA256(path)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, objectsSyncFile{
+			hash: hash,
+			key:  keyPrefix + rel,
+			path: path,
+			rel:  rel,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func objectsSyncFileHashes(files []objectsSyncFile) map[string]string {
+	hashes := make(map[string]string, len(files))
+
+	for _, v := range files {
+		hashes[v.key] = v.hash
+	}
+
+	return hashes
+}
+
+func fileSHA256(path string) (string, error) {
+	digest, err := fileDigest(path, sha256.New())
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(digest), nil
+}
+
+// uploadObjectsSyncFiles uploads the specified files in parallel, returning the uploaded objects' ETags keyed by object key.
+// Large files are uploaded in parts by the S3 upload manager.
+func uploadObjectsSyncFiles(ctx context.Context, conn *s3.Client, d *schema.ResourceData, files []objectsSyncFile, optFns ...func(*s3.Options)) (map[string]string, error) {
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	rules := expandObjectsSyncRules(d.Get("rule").([]interface{}))
+	// ResourceData isn't safe for concurrent use, so all settings are read before the uploads start.
+	base := expandObjectsSyncPutObjectInput(d)
+
+	var (
+		etags    = make(map[string]string, len(files))
+		failures []error
+		mu       sync.Mutex
+		sem      = make(chan struct{}, d.Get("concurrency").(int))
+		wg       sync.WaitGroup
+	)
+
+	for _, file := range files {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+
+			return etags, errors.Join(append(failures, ctx.Err())...)
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+
+		go func(file objectsSyncFile) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			etag, err := uploadObjectsSyncFile(ctx, uploader, base, rules, file)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				failures = append(failures, fmt.Errorf("uploading %s to S3 Object (%s): %w", file.path, file.key, err))
+				return
+			}
+
+			etags[file.key] = etag
+		}(file)
+	}
+
+	wg.Wait()
+
+	return etags, errors.Join(failures...)
+}
+
+// expandObjectsSyncPutObjectInput returns the PutObject settings shared by all uploaded objects.
+func expandObjectsSyncPutObjectInput(d *schema.ResourceData) s3.PutObjectInput {
+	input := s3.PutObjectInput{
+		Bucket: aws.String(d.Get("bucket").(string)),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = types.ObjectCannedACL(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = types.ServerSideEncryption(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = types.StorageClass(v.(string))
+	}
+
+	return input
+}
+
+// uploadObjectsSyncFile uploads a single file using a copy of the shared PutObject settings.
+func uploadObjectsSyncFile(ctx context.Context, uploader *manager.Uploader, base s3.PutObjectInput, rules []objectsSyncRule, file objectsSyncFile) (string, error) {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	input := &base
+	input.Body = body
+	input.Key = aws.String(file.key)
+
+	// Later rules override earlier ones.
+	for _, rule := range rules {
+		if !glob.MatchPath(rule.glob, file.rel) {
+			continue
+		}
+
+		if rule.cacheControl != "" {
+			input.CacheControl = aws.String(rule.cacheControl)
+		}
+		if rule.contentDisposition != "" {
+			input.ContentDisposition = aws.String(rule.contentDisposition)
+		}
+		if rule.contentEncoding != "" {
+			input.ContentEncoding = aws.String(rule.contentEncoding)
+		}
+		if rule.contentLanguage != "" {
+			input.ContentLanguage = aws.String(rule.contentLanguage)
+		}
+		if rule.contentType != "" {
+			input.ContentType = aws.String(rule.contentType)
+		}
+		if len(rule.metadata) > 0 {
+			if input.Metadata == nil {
+				input.Metadata = make(map[string]string)
+			}
+			maps.Copy(input.Metadata, rule.metadata)
+		}
+	}
+
+	if input.ContentType == nil {
+		contentType, err := detectContentType(body)
+		if err != nil {
+			return "", err
+		}
+		input.ContentType = aws.String(contentType)
+	}
+
+	output, err := uploader.Upload(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.ETag), nil
+}
+
+// detectContentType infers a file's MIME type from its extension, falling back to sniffing its content.
+func detectContentType(file *os.File) (string, error) {
+	if v := mime.TypeByExtension(filepath.Ext(file.Name())); v != "" {
+		return v, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := file.Read(buf)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+type objectsSyncRule struct {
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentLanguage    string
+	contentType        string
+	glob               string
+	metadata           map[string]string
+}
+
+func expandObjectsSyncRules(tfList []interface{}) []objectsSyncRule {
+	var rules []objectsSyncRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rules = append(rules, objectsSyncRule{
+			cacheControl:       tfMap["cache_control"].(string),
+			contentDisposition: tfMap["content_disposition"].(string),
+			contentEncoding:    tfMap["content_encoding"].(string),
+			contentLanguage:    tfMap["content_language"].(string),
+			contentType:        tfMap["content_type"].(string),
+			glob:               tfMap["glob"].(string),
+			metadata:           flex.ExpandStringValueMap(tfMap["metadata"].(map[string]interface{})),
+		})
+	}
+
+	return rules
+}
+
+func validateObjectsSyncGlob(v interface{}, k string) (ws []string, errors []error) {
+	if err := glob.ValidatePattern(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s): %w", k, v, err))
+	}
+
+	return
+}
+
+func normalizeETag(etag string) string {
+	return strings.Trim(etag, `"`)
+}
+
+// deleteUnmanagedObjects deletes every object under the specified prefix that doesn't correspond to one of the specified files.
+func deleteUnmanagedObjects(ctx context.Context, conn *s3.Client, bucket, keyPrefix string, files []objectsSyncFile, optFns ...func(*s3.Options)) error {
+	objects, err := findObjectsByBucketAndPrefix(ctx, conn, bucket, keyPrefix, optFns...)
+
+	if err != nil {
+		return fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	hashes := objectsSyncFileHashes(files)
+	var keys []string
+	for key := range objects {
+		if _, ok := hashes[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	return deleteObjectsByKeys(ctx, conn, bucket, keys, optFns...)
+}
+
+// deleteObjectsByKeys deletes the current versions of the specified objects in batches.
+func deleteObjectsByKeys(ctx context.Context, conn *s3.Client, bucket string, keys []string, optFns ...func(*s3.Options)) error {
+	var failures []error
+
+	for _, chunk := range tfslices.Chunks(keys, deleteObjectsMaxKeys) {
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &types.Delete{
+				Objects: tfslices.ApplyToAll(chunk, func(v string) types.ObjectIdentifier {
+					return types.ObjectIdentifier{
+						Key: aws.String(v),
+					}
+				}),
+				Quiet: aws.Bool(true), // Only report errors.
+			},
+		}
+
+		output, err := conn.DeleteObjects(ctx, input, optFns...)
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range output.Errors {
+			failures = append(failures, newDeleteObjectVersionError(v))
+		}
+	}
+
+	if err := errors.Join(failures...); err != nil {
+		return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	return nil
+}
+
+// findObjectsByBucketAndPrefix returns the objects under the specified prefix, keyed by object key.
+func findObjectsByBucketAndPrefix(ctx context.Context, conn *s3.Client, bucket, keyPrefix string, optFns ...func(*s3.Options)) (map[string]types.Object, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+	output := make(map[string]types.Object)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			output[aws.ToString(v.Key)] = v
+		}
+	}
+
+	return output, nil
+}
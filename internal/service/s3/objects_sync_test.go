@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ObjectsSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_objects_sync.test"
+	sourceDir := testAccObjectsSyncCreateSourceDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/site.css":  "body {}",
+		"img/.DS_Store": "ignored",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/css/site.css"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/index.html", "text/html", "no-cache"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/css/site.css", "text/css", "max-age=31536000"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("<html><body></body></html>"), 0644); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectsSyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckObjectsSyncObjectNotExists(ctx, resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsSync_deleteUnmanaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_objects_sync.test"
+	sourceDir := testAccObjectsSyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_unmanaged(rName, sourceDir, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_unmanaged", "false"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+				),
+			},
+			{
+				Config: testAccObjectsSyncConfig_unmanaged(rName, sourceDir, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_unmanaged", "true"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckObjectsSyncObjectNotExists(ctx, resourceName, "site/unmanaged.txt"),
+				),
+				// The unmanaged object resource sees its object deleted.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsSync_keyPrefixWithoutTrailingSlash(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccObjectsSyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectsSyncConfig_keyPrefix(rName, sourceDir, "site"),
+				ExpectError: regexache.MustCompile(`must end with a forward slash`),
+			},
+		},
+	})
+}
+
+func testAccObjectsSyncCreateSourceDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for k, v := range files {
+		path := filepath.Join(dir, filepath.FromSlash(k))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func testAccCheckObjectsSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_objects_sync" {
+				continue
+			}
+
+			for k := range rs.Primary.Attributes {
+				key, ok := testAccObjectsSyncKeyFromAttribute(k)
+				if !ok {
+					continue
+				}
+
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Object %s still exists", key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for k := range rs.Primary.Attributes {
+			key, ok := testAccObjectsSyncKeyFromAttribute(k)
+			if !ok {
+				continue
+			}
+
+			if _, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, rs.Primary.Attributes["etags."+key], ""); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObject(ctx context.Context, n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		// The system MIME type tables may omit the charset parameter.
+		if got, want := aws.ToString(output.ContentType), contentType; !strings.HasPrefix(got, want) {
+			return fmt.Errorf("S3 Object (%s) content type = %s, want %s", key, got, want)
+		}
+
+		if got, want := aws.ToString(output.CacheControl), cacheControl; got != want {
+			return fmt.Errorf("S3 Object (%s) cache control = %s, want %s", key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object %s still exists", key)
+	}
+}
+
+// testAccObjectsSyncKeyFromAttribute returns the object key for a "files" map attribute.
+func testAccObjectsSyncKeyFromAttribute(k string) (string, bool) {
+	key, ok := strings.CutPrefix(k, "files.")
+
+	return key, ok && key != "%"
+}
+
+func testAccObjectsSyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccObjectsSyncConfig_basic(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccObjectsSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+  exclude    = ["**/.DS_Store"]
+
+  rule {
+    glob          = "**"
+    cache_control = "max-age=31536000"
+  }
+
+  rule {
+    glob          = "**/*.html"
+    cache_control = "no-cache"
+  }
+}
+`, sourceDir))
+}
+
+func testAccObjectsSyncConfig_unmanaged(rName, sourceDir string, deleteUnmanaged bool) string {
+	return acctest.ConfigCompose(testAccObjectsSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "unmanaged" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "site/unmanaged.txt"
+  content = "unmanaged"
+}
+
+resource "aws_s3_objects_sync" "test" {
+  bucket           = aws_s3_bucket.test.bucket
+  key_prefix       = "site/"
+  source_dir       = %[1]q
+  delete_unmanaged = %[2]t
+
+  depends_on = [aws_s3_object.unmanaged]
+}
+`, sourceDir, deleteUnmanaged))
+}
+
+func testAccObjectsSyncConfig_keyPrefix(rName, sourceDir, keyPrefix string) string {
+	return acctest.ConfigCompose(testAccObjectsSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = %[2]q
+  source_dir = %[1]q
+}
+`, sourceDir, keyPrefix))
+}
@@ -203,6 +203,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "ObjectCopy",
 			},
 		},
+		{
+			Factory:  resourceObjectsSync,
+			TypeName: "aws_s3_objects_sync",
+			Name:     "Objects Sync",
+		},
 	}
 }
 
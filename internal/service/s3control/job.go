@@ -0,0 +1,1926 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	jobResourceIDPartCount = 2
+)
+
+var (
+	jobOperationExactlyOneOf = []string{
+		"operation.0.lambda_invoke",
+		"operation.0.s3_delete_object_tagging",
+		"operation.0.s3_initiate_restore_object",
+		"operation.0.s3_put_object_copy",
+		"operation.0.s3_put_object_legal_hold",
+		"operation.0.s3_put_object_retention",
+		"operation.0.s3_put_object_tagging",
+		"operation.0.s3_replicate_object",
+	}
+)
+
+// @SDKResource("aws_s3control_job", name="Job")
+// @Tags
+func resourceJob() *schema.Resource {
+	timeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"manifest", "manifest_generator"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.JobManifestFieldName](),
+										},
+									},
+									"format": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.JobManifestFormat](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"manifest_generator": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"manifest", "manifest_generator"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_manifest_output": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"expected_bucket_owner": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"created_after":  timeSchema(),
+									"created_before": timeSchema(),
+									"eligible_for_replication": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"key_name_constraint": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"match_any_prefix": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"match_any_substring": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"match_any_suffix": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"match_any_storage_class": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+										},
+									},
+									"object_replication_statuses": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.ReplicationStatus](),
+										},
+									},
+									"object_size_greater_than_bytes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than_bytes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"manifest_output_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"expected_manifest_bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"manifest_encryption": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sse_kms": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"key_id": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+														},
+													},
+												},
+												"sse_s3": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{},
+													},
+												},
+											},
+										},
+									},
+									"manifest_format": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.GeneratedManifestFormat](),
+									},
+									"manifest_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+						"source_bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"invocation_schema_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"1.0", "2.0"}, false),
+									},
+									"user_arguments": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"s3_delete_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"glacier_job_tier": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3GlacierJobTier](),
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3CannedAccessControlList](),
+									},
+									"checksum_algorithm": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ChecksumAlgorithm](),
+									},
+									"metadata_directive": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3MetadataDirective](),
+									},
+									"modified_since_constraint": timeSchema(),
+									"new_object_metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cache_control": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_disposition": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_encoding": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_language": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"http_expires_date": timeSchema(),
+												"sse_algorithm": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.S3SSEAlgorithm](),
+												},
+												"user_metadata": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"object_lock_legal_hold_status": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockLegalHoldStatus](),
+									},
+									"object_lock_mode": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockMode](),
+									},
+									"object_lock_retain_until_date": timeSchema(),
+									"redirect_location": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2000),
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+									},
+									"target_key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"unmodified_since_constraint": timeSchema(),
+								},
+							},
+						},
+						"s3_put_object_legal_hold": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockLegalHoldStatus](),
+									},
+								},
+							},
+						},
+						"s3_put_object_retention": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bypass_governance_retention": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"mode": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockRetentionMode](),
+									},
+									"retain_until_date": timeSchema(),
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"s3_replicate_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, math.MaxInt32),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportFormat](),
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"report_scope": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportScope](),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"confirmation_required"},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int32(int32(d.Get("priority").(int))),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		Tags:                 getTagsInS3(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_generator"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManifestGenerator = &types.JobManifestGeneratorMemberS3JobManifestGenerator{
+			Value: expandS3JobManifestGenerator(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Batch Operations Job: %s", err)
+	}
+
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{accountID, aws.ToString(output.JobId)}, jobResourceIDPartCount, false)))
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	job, err := waitJobCreated(ctx, conn, accountID, aws.ToString(output.JobId), timeout)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("wait_for_completion").(bool) {
+		job, err = waitJobCompleted(ctx, conn, accountID, aws.ToString(output.JobId), timeout)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) complete: %s", d.Id(), err)
+		}
+
+		if v := job.ProgressSummary; v != nil && aws.ToInt64(v.NumberOfTasksFailed) > 0 {
+			diags = sdkdiag.AppendWarningf(diags, "S3 Batch Operations Job (%s) completed with %d of %d tasks failed; see the job completion report for details", d.Id(), aws.ToInt64(v.NumberOfTasksFailed), aws.ToInt64(v.TotalNumberOfTasks))
+		}
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	accountID, jobID := parts[0], parts[1]
+	job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("description", job.Description)
+	if err := d.Set("failure_reasons", flattenJobFailures(job.FailureReasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting failure_reasons: %s", err)
+	}
+	d.Set("job_id", job.JobId)
+	if job.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(job.Manifest)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting manifest: %s", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if v, ok := job.ManifestGenerator.(*types.JobManifestGeneratorMemberS3JobManifestGenerator); ok {
+		if err := d.Set("manifest_generator", []interface{}{flattenS3JobManifestGenerator(&v.Value)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting manifest_generator: %s", err)
+		}
+	} else {
+		d.Set("manifest_generator", nil)
+	}
+	if job.Operation != nil {
+		if err := d.Set("operation", []interface{}{flattenJobOperation(ctx, job.Operation)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting operation: %s", err)
+		}
+	} else {
+		d.Set("operation", nil)
+	}
+	d.Set("priority", job.Priority)
+	if job.ProgressSummary != nil {
+		if err := d.Set("progress_summary", []interface{}{flattenJobProgressSummary(job.ProgressSummary)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting progress_summary: %s", err)
+		}
+	} else {
+		d.Set("progress_summary", nil)
+	}
+	if job.Report != nil {
+		if err := d.Set("report", []interface{}{flattenJobReport(job.Report)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting report: %s", err)
+		}
+	} else {
+		d.Set("report", nil)
+	}
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+	d.Set("status_update_reason", job.StatusUpdateReason)
+
+	tags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	setTagsOutS3(ctx, tagsS3(tags))
+
+	return diags
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	accountID, jobID := parts[0], parts[1]
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  int32(d.Get("priority").(int)),
+		}
+
+		_, err := conn.UpdateJobPriority(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Batch Operations Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		o, n := d.GetChange(names.AttrTagsAll)
+
+		if err := jobUpdateTags(ctx, conn, accountID, jobID, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Batch Operations Job (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	accountID, jobID := parts[0], parts[1]
+
+	// Jobs can't be deleted. S3 removes their records 90 days after they finish.
+	// Jobs that haven't finished are cancelled.
+	switch types.JobStatus(d.Get("status").(string)) {
+	case types.JobStatusCancelled, types.JobStatusComplete, types.JobStatusFailed:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	_, err = conn.UpdateJobStatus(ctx, &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: types.RequestedJobStatusCancelled,
+		StatusUpdateReason: aws.String("Deleted by Terraform"),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	// The job finished since it was last read.
+	if errs.IsA[*types.JobStatusException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobCancelled(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Batch Operations Job (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*types.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func statusJob(ctx context.Context, conn *s3control.Client, accountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitJobCreated(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.JobStatusNew, types.JobStatusPreparing),
+		Target: enum.Slice(
+			types.JobStatusActive,
+			types.JobStatusCancelled,
+			types.JobStatusCancelling,
+			types.JobStatusComplete,
+			types.JobStatusCompleting,
+			types.JobStatusPaused,
+			types.JobStatusPausing,
+			types.JobStatusReady,
+			types.JobStatusSuspended,
+		),
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		tfresource.SetLastError(err, jobError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCompleted(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			types.JobStatusActive,
+			types.JobStatusCompleting,
+			types.JobStatusNew,
+			types.JobStatusPaused,
+			types.JobStatusPausing,
+			types.JobStatusPreparing,
+			types.JobStatusReady,
+			types.JobStatusSuspended,
+		),
+		Target:     enum.Slice(types.JobStatusComplete),
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		tfresource.SetLastError(err, jobError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCancelled(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			types.JobStatusActive,
+			types.JobStatusCancelling,
+			types.JobStatusCompleting,
+			types.JobStatusFailing,
+			types.JobStatusNew,
+			types.JobStatusPaused,
+			types.JobStatusPausing,
+			types.JobStatusPreparing,
+			types.JobStatusReady,
+			types.JobStatusSuspended,
+		),
+		Target:     enum.Slice(types.JobStatusCancelled, types.JobStatusComplete, types.JobStatusFailed),
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// jobError returns an error describing why the specified job failed, including its task failure counts.
+func jobError(apiObject *types.JobDescriptor) error {
+	var failures []error
+
+	for _, v := range apiObject.FailureReasons {
+		failures = append(failures, fmt.Errorf("%s: %s", aws.ToString(v.FailureCode), aws.ToString(v.FailureReason)))
+	}
+
+	if v := aws.ToString(apiObject.StatusUpdateReason); v != "" {
+		failures = append(failures, errors.New(v))
+	}
+
+	if v := apiObject.ProgressSummary; v != nil && aws.ToInt64(v.NumberOfTasksFailed) > 0 {
+		failures = append(failures, fmt.Errorf("%d of %d tasks failed", aws.ToInt64(v.NumberOfTasksFailed), aws.ToInt64(v.TotalNumberOfTasks)))
+	}
+
+	return errors.Join(failures...)
+}
+
+func jobListTags(ctx context.Context, conn *s3control.Client, accountID, jobID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.GetJobTagging(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsS3(ctx, output.Tags), nil
+}
+
+func jobUpdateTags(ctx context.Context, conn *s3control.Client, accountID, jobID string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("listing tags: %s", err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Tags:      tagsS3(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("setting tags: %s", err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		}
+
+		_, err := conn.DeleteJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting tags: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *types.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandJobManifestLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Spec = expandJobManifestSpec(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJobManifestLocation(tfMap map[string]interface{}) *types.JobManifestLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestLocation{}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+		apiObject.ObjectArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+		apiObject.ObjectVersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestSpec(tfMap map[string]interface{}) *types.JobManifestSpec {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestSpec{}
+
+	if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Fields = flex.ExpandStringyValueList[types.JobManifestFieldName](v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.JobManifestFormat(v)
+	}
+
+	return apiObject
+}
+
+func expandS3JobManifestGenerator(tfMap map[string]interface{}) types.S3JobManifestGenerator {
+	apiObject := types.S3JobManifestGenerator{}
+
+	if v, ok := tfMap["enable_manifest_output"].(bool); ok {
+		apiObject.EnableManifestOutput = v
+	}
+
+	if v, ok := tfMap["expected_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandJobManifestGeneratorFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_output_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestOutputLocation = expandS3ManifestOutputLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_bucket"].(string); ok && v != "" {
+		apiObject.SourceBucket = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestGeneratorFilter(tfMap map[string]interface{}) *types.JobManifestGeneratorFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestGeneratorFilter{}
+
+	if v, ok := tfMap["created_after"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedAfter = aws.Time(v)
+	}
+
+	if v, ok := tfMap["created_before"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedBefore = aws.Time(v)
+	}
+
+	if v, ok := tfMap["eligible_for_replication"].(bool); ok && v {
+		apiObject.EligibleForReplication = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["key_name_constraint"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KeyNameConstraint = expandKeyNameConstraint(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["match_any_storage_class"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyStorageClass = flex.ExpandStringyValueSet[types.S3StorageClass](v)
+	}
+
+	if v, ok := tfMap["object_replication_statuses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ObjectReplicationStatuses = flex.ExpandStringyValueSet[types.ReplicationStatus](v)
+	}
+
+	if v, ok := tfMap["object_size_greater_than_bytes"].(int); ok && v > 0 {
+		apiObject.ObjectSizeGreaterThanBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than_bytes"].(int); ok && v > 0 {
+		apiObject.ObjectSizeLessThanBytes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandKeyNameConstraint(tfMap map[string]interface{}) *types.KeyNameConstraint {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.KeyNameConstraint{}
+
+	if v, ok := tfMap["match_any_prefix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyPrefix = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_substring"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnySubstring = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["match_any_suffix"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnySuffix = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ManifestOutputLocation(tfMap map[string]interface{}) *types.S3ManifestOutputLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ManifestOutputLocation{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["expected_manifest_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedManifestBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestEncryption = expandGeneratedManifestEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_format"].(string); ok && v != "" {
+		apiObject.ManifestFormat = types.GeneratedManifestFormat(v)
+	}
+
+	if v, ok := tfMap["manifest_prefix"].(string); ok && v != "" {
+		apiObject.ManifestPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGeneratedManifestEncryption(tfMap map[string]interface{}) *types.GeneratedManifestEncryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.GeneratedManifestEncryption{}
+
+	if v, ok := tfMap["sse_kms"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["key_id"].(string); ok && v != "" {
+			apiObject.SSEKMS = &types.SSEKMSEncryption{
+				KeyId: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["sse_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SSES3 = &types.SSES3Encryption{}
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(ctx context.Context, tfMap map[string]interface{}) *types.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LambdaInvoke = expandLambdaInvokeOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_delete_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3DeleteObjectTagging = &types.S3DeleteObjectTaggingOperation{}
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3InitiateRestoreObject = expandS3InitiateRestoreObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(ctx, v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_legal_hold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectLegalHold = expandS3SetObjectLegalHoldOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_retention"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectRetention = expandS3SetObjectRetentionOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3PutObjectTagging = &types.S3SetObjectTaggingOperation{}
+
+		if v, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := v["tag_set"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.S3PutObjectTagging.TagSet = tagsS3(tftags.New(ctx, v))
+			}
+		}
+	}
+
+	if v, ok := tfMap["s3_replicate_object"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3ReplicateObject = &types.S3ReplicateObjectOperation{}
+	}
+
+	return apiObject
+}
+
+func expandLambdaInvokeOperation(tfMap map[string]interface{}) *types.LambdaInvokeOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LambdaInvokeOperation{}
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["invocation_schema_version"].(string); ok && v != "" {
+		apiObject.InvocationSchemaVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_arguments"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserArguments = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3InitiateRestoreObjectOperation(tfMap map[string]interface{}) *types.S3InitiateRestoreObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3InitiateRestoreObjectOperation{}
+
+	if v, ok := tfMap["expiration_in_days"].(int); ok && v > 0 {
+		apiObject.ExpirationInDays = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+		apiObject.GlacierJobTier = types.S3GlacierJobTier(v)
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(ctx context.Context, tfMap map[string]interface{}) *types.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok {
+		apiObject.BucketKeyEnabled = v
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = types.S3CannedAccessControlList(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = types.S3ChecksumAlgorithm(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = types.S3MetadataDirective(v)
+	}
+
+	if v, ok := tfMap["modified_since_constraint"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ModifiedSinceConstraint = aws.Time(v)
+	}
+
+	if v, ok := tfMap["new_object_metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NewObjectMetadata = expandS3ObjectMetadata(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.NewObjectTagging = tagsS3(tftags.New(ctx, v))
+	}
+
+	if v, ok := tfMap["object_lock_legal_hold_status"].(string); ok && v != "" {
+		apiObject.ObjectLockLegalHoldStatus = types.S3ObjectLockLegalHoldStatus(v)
+	}
+
+	if v, ok := tfMap["object_lock_mode"].(string); ok && v != "" {
+		apiObject.ObjectLockMode = types.S3ObjectLockMode(v)
+	}
+
+	if v, ok := tfMap["object_lock_retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ObjectLockRetainUntilDate = aws.Time(v)
+	}
+
+	if v, ok := tfMap["redirect_location"].(string); ok && v != "" {
+		apiObject.RedirectLocation = aws.String(v)
+	}
+
+	if v, ok := tfMap["requester_pays"].(bool); ok {
+		apiObject.RequesterPays = v
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = types.S3StorageClass(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	if v, ok := tfMap["unmodified_since_constraint"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.UnModifiedSinceConstraint = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectMetadata(tfMap map[string]interface{}) *types.S3ObjectMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ObjectMetadata{}
+
+	if v, ok := tfMap["cache_control"].(string); ok && v != "" {
+		apiObject.CacheControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_disposition"].(string); ok && v != "" {
+		apiObject.ContentDisposition = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_encoding"].(string); ok && v != "" {
+		apiObject.ContentEncoding = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_language"].(string); ok && v != "" {
+		apiObject.ContentLanguage = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_type"].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["http_expires_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.HttpExpiresDate = aws.Time(v)
+	}
+
+	if v, ok := tfMap["sse_algorithm"].(string); ok && v != "" {
+		apiObject.SSEAlgorithm = types.S3SSEAlgorithm(v)
+	}
+
+	if v, ok := tfMap["user_metadata"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserMetadata = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectLegalHoldOperation(tfMap map[string]interface{}) *types.S3SetObjectLegalHoldOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3SetObjectLegalHoldOperation{}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.LegalHold = &types.S3ObjectLockLegalHold{
+			Status: types.S3ObjectLockLegalHoldStatus(v),
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectRetentionOperation(tfMap map[string]interface{}) *types.S3SetObjectRetentionOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3SetObjectRetentionOperation{
+		Retention: &types.S3Retention{},
+	}
+
+	if v, ok := tfMap["bypass_governance_retention"].(bool); ok && v {
+		apiObject.BypassGovernanceRetention = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Retention.Mode = types.S3ObjectLockRetentionMode(v)
+	}
+
+	if v, ok := tfMap["retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.Retention.RetainUntilDate = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandJobReport(tfMap map[string]interface{}) *types.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobReport{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = v
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.JobReportFormat(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = types.JobReportScope(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobFailures(apiObjects []types.JobFailure) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"failure_code":   aws.ToString(apiObject.FailureCode),
+			"failure_reason": aws.ToString(apiObject.FailureReason),
+		})
+	}
+
+	return tfList
+}
+
+func flattenJobManifest(apiObject *types.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{map[string]interface{}{
+			"etag":              aws.ToString(v.ETag),
+			"object_arn":        aws.ToString(v.ObjectArn),
+			"object_version_id": aws.ToString(v.ObjectVersionId),
+		}}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{map[string]interface{}{
+			"fields": enum.Slice(v.Fields...),
+			"format": v.Format,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3JobManifestGenerator(apiObject *types.S3JobManifestGenerator) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_manifest_output": apiObject.EnableManifestOutput,
+		"expected_bucket_owner":  aws.ToString(apiObject.ExpectedBucketOwner),
+		"source_bucket":          aws.ToString(apiObject.SourceBucket),
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = []interface{}{flattenJobManifestGeneratorFilter(v)}
+	}
+
+	if v := apiObject.ManifestOutputLocation; v != nil {
+		tfMap["manifest_output_location"] = []interface{}{flattenS3ManifestOutputLocation(v)}
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestGeneratorFilter(apiObject *types.JobManifestGeneratorFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"eligible_for_replication":       aws.ToBool(apiObject.EligibleForReplication),
+		"match_any_storage_class":        enum.Slice(apiObject.MatchAnyStorageClass...),
+		"object_replication_statuses":    enum.Slice(apiObject.ObjectReplicationStatuses...),
+		"object_size_greater_than_bytes": aws.ToInt64(apiObject.ObjectSizeGreaterThanBytes),
+		"object_size_less_than_bytes":    aws.ToInt64(apiObject.ObjectSizeLessThanBytes),
+	}
+
+	if v := apiObject.CreatedAfter; v != nil {
+		tfMap["created_after"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.CreatedBefore; v != nil {
+		tfMap["created_before"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.KeyNameConstraint; v != nil {
+		tfMap["key_name_constraint"] = []interface{}{map[string]interface{}{
+			"match_any_prefix":    v.MatchAnyPrefix,
+			"match_any_substring": v.MatchAnySubstring,
+			"match_any_suffix":    v.MatchAnySuffix,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3ManifestOutputLocation(apiObject *types.S3ManifestOutputLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":                         aws.ToString(apiObject.Bucket),
+		"expected_manifest_bucket_owner": aws.ToString(apiObject.ExpectedManifestBucketOwner),
+		"manifest_format":                apiObject.ManifestFormat,
+		"manifest_prefix":                aws.ToString(apiObject.ManifestPrefix),
+	}
+
+	if v := apiObject.ManifestEncryption; v != nil {
+		tfMapEncryption := map[string]interface{}{}
+
+		if v := v.SSEKMS; v != nil {
+			tfMapEncryption["sse_kms"] = []interface{}{map[string]interface{}{
+				"key_id": aws.ToString(v.KeyId),
+			}}
+		}
+
+		if v := v.SSES3; v != nil {
+			tfMapEncryption["sse_s3"] = []interface{}{map[string]interface{}{}}
+		}
+
+		tfMap["manifest_encryption"] = []interface{}{tfMapEncryption}
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(ctx context.Context, apiObject *types.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{map[string]interface{}{
+			"function_arn":              aws.ToString(v.FunctionArn),
+			"invocation_schema_version": aws.ToString(v.InvocationSchemaVersion),
+			"user_arguments":            v.UserArguments,
+		}}
+	}
+
+	if v := apiObject.S3DeleteObjectTagging; v != nil {
+		tfMap["s3_delete_object_tagging"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{map[string]interface{}{
+			"expiration_in_days": aws.ToInt32(v.ExpirationInDays),
+			"glacier_job_tier":   v.GlacierJobTier,
+		}}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{flattenS3CopyObjectOperation(ctx, v)}
+	}
+
+	if v := apiObject.S3PutObjectLegalHold; v != nil {
+		tfMapLegalHold := map[string]interface{}{}
+
+		if v := v.LegalHold; v != nil {
+			tfMapLegalHold["status"] = v.Status
+		}
+
+		tfMap["s3_put_object_legal_hold"] = []interface{}{tfMapLegalHold}
+	}
+
+	if v := apiObject.S3PutObjectRetention; v != nil {
+		tfMapRetention := map[string]interface{}{
+			"bypass_governance_retention": aws.ToBool(v.BypassGovernanceRetention),
+		}
+
+		if v := v.Retention; v != nil {
+			tfMapRetention["mode"] = v.Mode
+
+			if v := v.RetainUntilDate; v != nil {
+				tfMapRetention["retain_until_date"] = aws.ToTime(v).Format(time.RFC3339)
+			}
+		}
+
+		tfMap["s3_put_object_retention"] = []interface{}{tfMapRetention}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{map[string]interface{}{
+			"tag_set": keyValueTagsS3(ctx, v.TagSet).Map(),
+		}}
+	}
+
+	if v := apiObject.S3ReplicateObject; v != nil {
+		tfMap["s3_replicate_object"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
+
+func flattenS3CopyObjectOperation(ctx context.Context, apiObject *types.S3CopyObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_key_enabled":            apiObject.BucketKeyEnabled,
+		"canned_access_control_list":    apiObject.CannedAccessControlList,
+		"checksum_algorithm":            apiObject.ChecksumAlgorithm,
+		"metadata_directive":            apiObject.MetadataDirective,
+		"new_object_tagging":            keyValueTagsS3(ctx, apiObject.NewObjectTagging).Map(),
+		"object_lock_legal_hold_status": apiObject.ObjectLockLegalHoldStatus,
+		"object_lock_mode":              apiObject.ObjectLockMode,
+		"redirect_location":             aws.ToString(apiObject.RedirectLocation),
+		"requester_pays":                apiObject.RequesterPays,
+		"sse_aws_kms_key_id":            aws.ToString(apiObject.SSEAwsKmsKeyId),
+		"storage_class":                 apiObject.StorageClass,
+		"target_key_prefix":             aws.ToString(apiObject.TargetKeyPrefix),
+		"target_resource":               aws.ToString(apiObject.TargetResource),
+	}
+
+	if v := apiObject.ModifiedSinceConstraint; v != nil {
+		tfMap["modified_since_constraint"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.NewObjectMetadata; v != nil {
+		tfMapMetadata := map[string]interface{}{
+			"cache_control":       aws.ToString(v.CacheControl),
+			"content_disposition": aws.ToString(v.ContentDisposition),
+			"content_encoding":    aws.ToString(v.ContentEncoding),
+			"content_language":    aws.ToString(v.ContentLanguage),
+			"content_type":        aws.ToString(v.ContentType),
+			"sse_algorithm":       v.SSEAlgorithm,
+			"user_metadata":       v.UserMetadata,
+		}
+
+		if v := v.HttpExpiresDate; v != nil {
+			tfMapMetadata["http_expires_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfMap["new_object_metadata"] = []interface{}{tfMapMetadata}
+	}
+
+	if v := apiObject.ObjectLockRetainUntilDate; v != nil {
+		tfMap["object_lock_retain_until_date"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.UnModifiedSinceConstraint; v != nil {
+		tfMap["unmodified_since_constraint"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenJobProgressSummary(apiObject *types.JobProgressSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"number_of_tasks_failed":    aws.ToInt64(apiObject.NumberOfTasksFailed),
+		"number_of_tasks_succeeded": aws.ToInt64(apiObject.NumberOfTasksSucceeded),
+		"total_number_of_tasks":     aws.ToInt64(apiObject.TotalNumberOfTasks),
+	}
+
+	return tfMap
+}
+
+func flattenJobReport(apiObject *types.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":       aws.ToString(apiObject.Bucket),
+		"enabled":      apiObject.Enabled,
+		"format":       apiObject.Format,
+		"prefix":       aws.ToString(apiObject.Prefix),
+		"report_scope": apiObject.ReportScope,
+	}
+
+	return tfMap
+}
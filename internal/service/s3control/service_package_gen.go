@@ -85,6 +85,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBucketPolicy,
 			TypeName: "aws_s3control_bucket_policy",
 		},
+		{
+			Factory:  resourceJob,
+			TypeName: "aws_s3control_job",
+			Name:     "Job",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceMultiRegionAccessPoint,
 			TypeName: "aws_s3control_multi_region_access_point",
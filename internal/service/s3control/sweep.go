@@ -9,7 +9,9 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -47,6 +49,11 @@ func RegisterSweepers() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_s3control_job", &resource.Sweeper{
+		Name: "aws_s3control_job",
+		F:    sweepJobs,
+	})
+
 	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
 		Name: "aws_s3control_multi_region_access_point",
 		F:    sweepMultiRegionAccessPoints,
@@ -240,6 +247,60 @@ func sweepAccessPoints(region string) error {
 	return nil
 }
 
+func sweepJobs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.S3ControlClient(ctx)
+	accountID := client.AccountID
+	// Finished jobs can't be deleted, so only cancel unfinished ones.
+	input := &s3control.ListJobsInput{
+		AccountId: aws.String(accountID),
+		JobStatuses: []types.JobStatus{
+			types.JobStatusActive,
+			types.JobStatusNew,
+			types.JobStatusPaused,
+			types.JobStatusPreparing,
+			types.JobStatusReady,
+			types.JobStatusSuspended,
+		},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := s3control.NewListJobsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping S3 Batch Operations Job sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing S3 Batch Operations Jobs (%s): %w", region, err)
+		}
+
+		for _, v := range page.Jobs {
+			r := resourceJob()
+			d := r.Data(nil)
+			d.SetId(errs.Must(flex.FlattenResourceId([]string{accountID, aws.ToString(v.JobId)}, jobResourceIDPartCount, false)))
+			d.Set("status", v.Status)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Batch Operations Jobs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepMultiRegionAccessPoints(region string) error {
 	ctx := sweep.Context(region)
 	if region != names.USWest2RegionID {
@@ -7,10 +7,12 @@ package secretsmanager
 var (
 	ResourceSecret         = resourceSecret
 	ResourceSecretPolicy   = resourceSecretPolicy
+	ResourceSecretReplica  = resourceSecretReplica
 	ResourceSecretRotation = resourceSecretRotation
 	ResourceSecretVersion  = resourceSecretVersion
 
 	FindSecretByID                = findSecretByID
 	FindSecretPolicyByID          = findSecretPolicyByID
+	FindSecretReplicaByTwoPartKey = findSecretReplicaByTwoPartKey
 	FindSecretVersionByTwoPartKey = findSecretVersionByTwoPartKey
 )
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	secretReplicaResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_secretsmanager_secret_replica", name="Secret Replica")
+func resourceSecretReplica() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecretReplicaCreate,
+		ReadWithoutTimeout:   resourceSecretReplicaRead,
+		UpdateWithoutTimeout: resourceSecretReplicaUpdate,
+		DeleteWithoutTimeout: resourceSecretReplicaDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"force_overwrite_replica_secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"last_accessed_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"promote_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecretReplicaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID, region := d.Get("secret_id").(string), d.Get("region").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{secretID, region}, secretReplicaResourceIDPartCount, false))
+	replica := types.ReplicaRegionType{
+		Region: aws.String(region),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		replica.KmsKeyId = aws.String(v.(string))
+	}
+
+	if err := addSecretReplicas(ctx, conn, secretID, d.Get("force_overwrite_replica_secret").(bool), []types.ReplicaRegionType{replica}); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitSecretReplicaInSync(ctx, conn, secretID, region, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret Replica (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSecretReplicaRead(ctx, d, meta)...)
+}
+
+func resourceSecretReplicaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), secretReplicaResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	secretID, region := parts[0], parts[1]
+	secret, replica, err := findSecretReplicaByTwoPartKey(ctx, conn, secretID, region)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Secrets Manager Secret Replica (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret Replica (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", secretReplicaARN(aws.ToString(secret.ARN), region))
+	d.Set("kms_key_id", replica.KmsKeyId)
+	if v := replica.LastAccessedDate; v != nil {
+		d.Set("last_accessed_date", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("last_accessed_date", nil)
+	}
+	d.Set("region", replica.Region)
+	d.Set("secret_id", secretID)
+	d.Set("status", replica.Status)
+	d.Set("status_message", replica.StatusMessage)
+
+	return diags
+}
+
+func resourceSecretReplicaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only Terraform-side arguments (force_overwrite_replica_secret, promote_on_destroy) can be updated.
+
+	return append(diags, resourceSecretReplicaRead(ctx, d, meta)...)
+}
+
+func resourceSecretReplicaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), secretReplicaResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	secretID, region := parts[0], parts[1]
+
+	if d.Get("promote_on_destroy").(bool) {
+		log.Printf("[DEBUG] Promoting Secrets Manager Secret Replica: %s", d.Id())
+		input := &secretsmanager.StopReplicationToReplicaInput{
+			SecretId: aws.String(d.Get("arn").(string)),
+		}
+
+		_, err = conn.StopReplicationToReplica(ctx, input, func(o *secretsmanager.Options) {
+			// The replica is promoted from its own Region.
+			o.Region = region
+		})
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "promoting Secrets Manager Secret Replica (%s): %s", d.Id(), err)
+		}
+	} else {
+		log.Printf("[DEBUG] Deleting Secrets Manager Secret Replica: %s", d.Id())
+		if err := removeSecretReplicas(ctx, conn, secretID, []types.ReplicaRegionType{{Region: aws.String(region)}}); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		_, replica, err := findSecretReplicaByTwoPartKey(ctx, conn, secretID, region)
+		return replica, err
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret Replica (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// secretReplicaARN returns the ARN of the replica of the specified secret in the specified Region.
+func secretReplicaARN(secretARN, region string) string {
+	v, err := arn.Parse(secretARN)
+
+	if err != nil {
+		return ""
+	}
+
+	v.Region = region
+
+	return v.String()
+}
+
+func findSecretReplicaByTwoPartKey(ctx context.Context, conn *secretsmanager.Client, secretID, region string) (*secretsmanager.DescribeSecretOutput, *types.ReplicationStatusType, error) {
+	secret, err := findSecretByID(ctx, conn, secretID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replica, err := tfresource.AssertSingleValueResult(tfslices.Filter(secret.ReplicationStatus, func(v types.ReplicationStatusType) bool {
+		return aws.ToString(v.Region) == region
+	}))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return secret, replica, nil
+}
+
+func statusSecretReplica(ctx context.Context, conn *secretsmanager.Client, secretID, region string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		_, output, err := findSecretReplicaByTwoPartKey(ctx, conn, secretID, region)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitSecretReplicaInSync(ctx context.Context, conn *secretsmanager.Client, secretID, region string, timeout time.Duration) (*types.ReplicationStatusType, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.StatusTypeInProgress),
+		Target:  enum.Slice(types.StatusTypeInSync),
+		Refresh: statusSecretReplica(ctx, conn, secretID, region),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.ReplicationStatusType); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
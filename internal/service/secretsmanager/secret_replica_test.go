@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretReplica_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var replica types.ReplicationStatusType
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_replica.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckSecretReplicaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretReplicaConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretReplicaExists(ctx, resourceName, &replica),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "force_overwrite_replica_secret", "false"),
+					resource.TestCheckResourceAttr(resourceName, "promote_on_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_id", "aws_secretsmanager_secret.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.StatusTypeInSync)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_overwrite_replica_secret", "promote_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretReplica_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var replica types.ReplicationStatusType
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_replica.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckSecretReplicaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretReplicaConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretReplicaExists(ctx, resourceName, &replica),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecretsmanager.ResourceSecretReplica(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretReplica_promoteOnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var replica types.ReplicationStatusType
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_replica.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckSecretReplicaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretReplicaConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretReplicaExists(ctx, resourceName, &replica),
+					resource.TestCheckResourceAttr(resourceName, "promote_on_destroy", "true"),
+				),
+			},
+			{
+				// Promote the replica to a standalone secret in the alternate Region.
+				Config: testAccSecretReplicaConfig_promoted(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_secretsmanager_secret.promoted", "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecretReplicaDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_secretsmanager_secret_replica" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = tfsecretsmanager.FindSecretReplicaByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Secrets Manager Secret Replica %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecretReplicaExists(ctx context.Context, n string, v *types.ReplicationStatusType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		_, output, err := tfsecretsmanager.FindSecretReplicaByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSecretReplicaConfig_basic(rName string, promoteOnDestroy bool) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = awsalternate
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_replica" "test" {
+  secret_id          = aws_secretsmanager_secret.test.id
+  region             = data.aws_region.alternate.name
+  promote_on_destroy = %[2]t
+}
+`, rName, promoteOnDestroy))
+}
+
+func testAccSecretReplicaConfig_promoted(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+data "aws_secretsmanager_secret" "promoted" {
+  provider = awsalternate
+
+  name = %[1]q
+}
+`, rName))
+}
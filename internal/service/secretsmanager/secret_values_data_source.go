@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfiltersv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+const (
+	// batchGetSecretValueMaxSecretIDs is the maximum number of secret IDs in a single BatchGetSecretValue request.
+	batchGetSecretValueMaxSecretIDs = 20
+)
+
+// @SDKDataSource("aws_secretsmanager_secret_values", name="Secret Values")
+func dataSourceSecretValues() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecretValuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": func() *schema.Schema {
+				v := namevaluesfiltersv2.Schema()
+				v.ExactlyOneOf = []string{"filter", "secret_ids"}
+				return v
+			}(),
+			"secret_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+				ExactlyOneOf: []string{"filter", "secret_ids"},
+			},
+			"secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_binary": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"secret_string": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_stages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecretValuesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	var secretValues []types.SecretValueEntry
+	var apiErrors []types.APIErrorType
+
+	if v, ok := d.GetOk("secret_ids"); ok && v.(*schema.Set).Len() > 0 {
+		secretIDs := flex.ExpandStringValueSet(v.(*schema.Set))
+
+		for _, chunk := range tfslices.Chunks(secretIDs, batchGetSecretValueMaxSecretIDs) {
+			input := &secretsmanager.BatchGetSecretValueInput{
+				SecretIdList: chunk,
+			}
+
+			values, errors, err := findSecretValues(ctx, conn, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret values: %s", err)
+			}
+
+			secretValues = append(secretValues, values...)
+			apiErrors = append(apiErrors, errors...)
+		}
+	} else {
+		input := &secretsmanager.BatchGetSecretValueInput{
+			Filters: namevaluesfiltersv2.New(d.Get("filter").(*schema.Set)).SecretsmanagerFilters(),
+		}
+
+		values, errors, err := findSecretValues(ctx, conn, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret values: %s", err)
+		}
+
+		secretValues, apiErrors = values, errors
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("errors", flattenAPIErrorTypes(apiErrors)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting errors: %s", err)
+	}
+	if err := d.Set("secrets", flattenSecretValueEntries(secretValues)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting secrets: %s", err)
+	}
+
+	// Per-secret errors are returned in the errors attribute and as warnings, so that one inaccessible secret doesn't fail every read.
+	for _, v := range apiErrors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("reading Secrets Manager Secret (%s) value", aws.ToString(v.SecretId)),
+			Detail:   fmt.Sprintf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.Message)),
+		})
+	}
+
+	return diags
+}
+
+func findSecretValues(ctx context.Context, conn *secretsmanager.Client, input *secretsmanager.BatchGetSecretValueInput) ([]types.SecretValueEntry, []types.APIErrorType, error) {
+	var secretValues []types.SecretValueEntry
+	var apiErrors []types.APIErrorType
+
+	paginator := secretsmanager.NewBatchGetSecretValuePaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		secretValues = append(secretValues, page.SecretValues...)
+		apiErrors = append(apiErrors, page.Errors...)
+	}
+
+	return secretValues, apiErrors, nil
+}
+
+func flattenSecretValueEntries(apiObjects []types.SecretValueEntry) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":            aws.ToString(apiObject.ARN),
+			"name":           aws.ToString(apiObject.Name),
+			"secret_binary":  string(apiObject.SecretBinary),
+			"secret_string":  aws.ToString(apiObject.SecretString),
+			"version_id":     aws.ToString(apiObject.VersionId),
+			"version_stages": apiObject.VersionStages,
+		}
+
+		if v := apiObject.CreatedDate; v != nil {
+			tfMap["created_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAPIErrorTypes(apiObjects []types.APIErrorType) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"error_code": aws.ToString(apiObject.ErrorCode),
+			"message":    aws.ToString(apiObject.Message),
+			"secret_id":  aws.ToString(apiObject.SecretId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretValuesDataSource_secretIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+	dataSourceName := "data.aws_secretsmanager_secret_values.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretValuesDataSourceConfig_secretIDs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "secrets.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secrets.0.created_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.name", rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "secrets.0.secret_string", resourceName, "secret_string"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "secrets.0.version_id", resourceName, "version_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.version_stages.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.version_stages.0", "AWSCURRENT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretValuesDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+	dataSourceName := "data.aws_secretsmanager_secret_values.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretValuesDataSourceConfig_base(rName),
+				// Sleep to allow secrets become visible in the list.
+				Check: acctest.CheckSleep(t, 30*time.Second),
+			},
+			{
+				Config: testAccSecretValuesDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "secrets.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "secrets.0.secret_string", resourceName, "secret_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretValuesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+`, rName)
+}
+
+func testAccSecretValuesDataSourceConfig_secretIDs(rName string) string {
+	return acctest.ConfigCompose(testAccSecretValuesDataSourceConfig_base(rName), `
+data "aws_secretsmanager_secret_values" "test" {
+  secret_ids = [aws_secretsmanager_secret_version.test.arn]
+}
+`)
+}
+
+func testAccSecretValuesDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccSecretValuesDataSourceConfig_base(rName), `
+data "aws_secretsmanager_secret_values" "test" {
+  filter {
+    name   = "name"
+    values = [aws_secretsmanager_secret.test.name]
+  }
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`)
+}
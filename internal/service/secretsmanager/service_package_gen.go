@@ -39,6 +39,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_secretsmanager_secret_rotation",
 			Name:     "Secret Rotation",
 		},
+		{
+			Factory:  dataSourceSecretValues,
+			TypeName: "aws_secretsmanager_secret_values",
+			Name:     "Secret Values",
+		},
 		{
 			Factory:  dataSourceSecretVersion,
 			TypeName: "aws_secretsmanager_secret_version",
@@ -67,6 +72,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_secretsmanager_secret_policy",
 			Name:     "Secret Policy",
 		},
+		{
+			Factory:  resourceSecretReplica,
+			TypeName: "aws_secretsmanager_secret_replica",
+			Name:     "Secret Replica",
+		},
 		{
 			Factory:  resourceSecretRotation,
 			TypeName: "aws_secretsmanager_secret_rotation",
@@ -6,7 +6,11 @@ package ssm
 // Exports for use in tests only.
 var (
 	ResourceDefaultPatchBaseline = resourceDefaultPatchBaseline
+	ResourceParameterLabel       = resourceParameterLabel
+	ResourceParameters           = resourceParameters
 	ResourcePatchBaseline        = resourcePatchBaseline
 
-	FindPatchBaselineByID = findPatchBaselineByID
+	FindParameterHistoryByTwoPartKey = findParameterHistoryByTwoPartKey
+	FindParametersByNames            = findParametersByNames
+	FindPatchBaselineByID            = findPatchBaselineByID
 )
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_ssm_parameter_history", name="Parameter History")
+func dataSourceParameterHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceParameterHistoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"history": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_pattern": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"last_modified_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"with_decryption": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func dataSourceParameterHistoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	name := d.Get("name").(string)
+	output, err := findParameterHistoryByName(ctx, conn, name, d.Get("with_decryption").(bool))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter (%s) history: %s", name, err)
+	}
+
+	d.SetId(name)
+	if err := d.Set("history", flattenParameterHistories(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting history: %s", err)
+	}
+
+	return diags
+}
+
+func flattenParameterHistories(apiObjects []types.ParameterHistory) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"allowed_pattern":    aws.ToString(apiObject.AllowedPattern),
+			"data_type":          aws.ToString(apiObject.DataType),
+			"description":        aws.ToString(apiObject.Description),
+			"key_id":             aws.ToString(apiObject.KeyId),
+			"labels":             apiObject.Labels,
+			"last_modified_user": aws.ToString(apiObject.LastModifiedUser),
+			"tier":               string(apiObject.Tier),
+			"type":               string(apiObject.Type),
+			"value":              aws.ToString(apiObject.Value),
+			"version":            apiObject.Version,
+		}
+
+		if v := apiObject.LastModifiedDate; v != nil {
+			tfMap["last_modified_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
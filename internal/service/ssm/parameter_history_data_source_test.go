@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameterHistoryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssm_parameter_history.test"
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterHistoryDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "history.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "history.0.description", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "history.0.labels.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "history.0.labels.0", "prod"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "history.0.last_modified_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "history.0.tier", "Standard"),
+					resource.TestCheckResourceAttr(dataSourceName, "history.0.type", "SecureString"),
+					resource.TestCheckResourceAttr(dataSourceName, "history.0.value", "secret"),
+					resource.TestCheckResourceAttr(dataSourceName, "history.0.version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccParameterHistoryDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name        = %[1]q
+  description = "test"
+  type        = "SecureString"
+  value       = "secret"
+}
+
+resource "aws_ssm_parameter_label" "test" {
+  name   = aws_ssm_parameter.test.name
+  labels = ["prod"]
+}
+
+data "aws_ssm_parameter_history" "test" {
+  name = aws_ssm_parameter_label.test.name
+}
+`, rName)
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	parameterLabelResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_ssm_parameter_label", name="Parameter Label")
+func resourceParameterLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParameterLabelCreate,
+		ReadWithoutTimeout:   resourceParameterLabelRead,
+		UpdateWithoutTimeout: resourceParameterLabelUpdate,
+		DeleteWithoutTimeout: resourceParameterLabelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"labels": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 100),
+						validation.StringMatch(regexache.MustCompile(`^[A-Za-z_.-][0-9A-Za-z_.-]*$`), "must contain only letters, numbers, periods (.), hyphens (-) or underscores (_) and can't begin with a number"),
+						validation.StringDoesNotMatch(regexache.MustCompile(`(?i)^(aws|ssm)`), `can't begin with "aws" or "ssm"`),
+					),
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"parameter_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceParameterLabelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &ssm.LabelParameterVersionInput{
+		Labels: flex.ExpandStringValueSet(d.Get("labels").(*schema.Set)),
+		Name:   aws.String(name),
+	}
+
+	// If no version is specified the latest version is labeled.
+	if v, ok := d.GetOk("parameter_version"); ok {
+		input.ParameterVersion = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.LabelParameterVersion(ctx, input)
+
+	if err == nil && len(output.InvalidLabels) > 0 {
+		err = fmt.Errorf("invalid labels: %v", output.InvalidLabels)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameter Label (%s): %s", name, err)
+	}
+
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{name, strconv.FormatInt(output.ParameterVersion, 10)}, parameterLabelResourceIDPartCount, false)))
+
+	return append(diags, resourceParameterLabelRead(ctx, d, meta)...)
+}
+
+func resourceParameterLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	name, version, err := parameterLabelParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	history, err := findParameterHistoryByTwoPartKey(ctx, conn, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Parameter Label (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter Label (%s): %s", d.Id(), err)
+	}
+
+	// Only track the labels managed by this resource, so that labels added elsewhere don't cause a diff.
+	// On import no labels are known yet, so all labels of the parameter version are adopted.
+	labels := history.Labels
+	if v := d.Get("labels").(*schema.Set); v.Len() > 0 {
+		labels = tfslices.Filter(labels, func(label string) bool {
+			return v.Contains(label)
+		})
+	}
+
+	if len(labels) == 0 && !d.IsNewResource() {
+		log.Printf("[WARN] SSM Parameter Label (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("labels", labels)
+	d.Set("name", history.Name)
+	d.Set("parameter_version", history.Version)
+
+	return diags
+}
+
+func resourceParameterLabelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	name, version, err := parameterLabelParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange("labels") {
+		o, n := d.GetChange("labels")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			input := &ssm.LabelParameterVersionInput{
+				Labels:           add,
+				Name:             aws.String(name),
+				ParameterVersion: aws.Int64(version),
+			}
+
+			output, err := conn.LabelParameterVersion(ctx, input)
+
+			if err == nil && len(output.InvalidLabels) > 0 {
+				err = fmt.Errorf("invalid labels: %v", output.InvalidLabels)
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "adding SSM Parameter Label (%s) labels: %s", d.Id(), err)
+			}
+		}
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			if err := unlabelParameterVersion(ctx, conn, name, version, del); err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing SSM Parameter Label (%s) labels: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceParameterLabelRead(ctx, d, meta)...)
+}
+
+func resourceParameterLabelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	name, version, err := parameterLabelParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting SSM Parameter Label: %s", d.Id())
+	err = unlabelParameterVersion(ctx, conn, name, version, flex.ExpandStringValueSet(d.Get("labels").(*schema.Set)))
+
+	if errs.IsA[*types.ParameterNotFound](err) || errs.IsA[*types.ParameterVersionNotFound](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameter Label (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func parameterLabelParseResourceID(id string) (string, int64, error) {
+	parts, err := flex.ExpandResourceId(id, parameterLabelResourceIDPartCount, false)
+	if err != nil {
+		return "", 0, err
+	}
+
+	version, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("parsing SSM Parameter Label version (%s): %w", parts[1], err)
+	}
+
+	return parts[0], version, nil
+}
+
+// unlabelParameterVersion removes the specified labels from the specified parameter version.
+// Labels that have since been moved to another version are ignored.
+func unlabelParameterVersion(ctx context.Context, conn *ssm.Client, name string, version int64, labels []string) error {
+	input := &ssm.UnlabelParameterVersionInput{
+		Labels:           labels,
+		Name:             aws.String(name),
+		ParameterVersion: aws.Int64(version),
+	}
+
+	_, err := conn.UnlabelParameterVersion(ctx, input)
+
+	return err
+}
+
+func findParameterHistoryByTwoPartKey(ctx context.Context, conn *ssm.Client, name string, version int64) (*types.ParameterHistory, error) {
+	output, err := findParameterHistoryByName(ctx, conn, name, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := tfresource.AssertSingleValueResult(tfslices.Filter(output, func(v types.ParameterHistory) bool {
+		return v.Version == version
+	}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func findParameterHistoryByName(ctx context.Context, conn *ssm.Client, name string, withDecryption bool) ([]types.ParameterHistory, error) {
+	input := &ssm.GetParameterHistoryInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(withDecryption),
+	}
+	var output []types.ParameterHistory
+
+	pages := ssm.NewGetParameterHistoryPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ParameterNotFound](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Parameters...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameterLabel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var history types.ParameterHistory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterLabelConfig_basic(rName, `"prod"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &history),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "prod"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", "aws_ssm_parameter.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameter_version", "aws_ssm_parameter.test", "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParameterLabelConfig_basic(rName, `"prod", "stable"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &history),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "prod"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "stable"),
+				),
+			},
+			{
+				Config: testAccParameterLabelConfig_basic(rName, `"stable"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &history),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "stable"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterLabel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var history types.ParameterHistory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterLabelConfig_basic(rName, `"prod"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &history),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameterLabel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterLabel_unmanagedLabels(t *testing.T) {
+	ctx := acctest.Context(t)
+	var history types.ParameterHistory
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+	otherResourceName := "aws_ssm_parameter_label.other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Each resource only tracks its own labels of the shared parameter version.
+				Config: testAccParameterLabelConfig_unmanagedLabels(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName, &history),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "prod"),
+					resource.TestCheckResourceAttr(otherResourceName, "labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr(otherResourceName, "labels.*", "stable"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParameterLabelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameter_label" {
+				continue
+			}
+
+			version, err := strconv.ParseInt(rs.Primary.Attributes["parameter_version"], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfssm.FindParameterHistoryByTwoPartKey(ctx, conn, rs.Primary.Attributes["name"], version)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output.Labels) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("SSM Parameter Label %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParameterLabelExists(ctx context.Context, n string, v *types.ParameterHistory) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		version, err := strconv.ParseInt(rs.Primary.Attributes["parameter_version"], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindParameterHistoryByTwoPartKey(ctx, conn, rs.Primary.Attributes["name"], version)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Labels) == 0 {
+			return fmt.Errorf("SSM Parameter Label %s has no labels", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccParameterLabelConfig_basic(rName, labels string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = "test"
+}
+
+resource "aws_ssm_parameter_label" "test" {
+  name              = aws_ssm_parameter.test.name
+  parameter_version = aws_ssm_parameter.test.version
+  labels            = [%[2]s]
+}
+`, rName, labels)
+}
+
+func testAccParameterLabelConfig_unmanagedLabels(rName string) string {
+	return acctest.ConfigCompose(testAccParameterLabelConfig_basic(rName, `"prod"`), `
+resource "aws_ssm_parameter_label" "other" {
+  name              = aws_ssm_parameter.test.name
+  parameter_version = aws_ssm_parameter.test.version
+  labels            = ["stable"]
+}
+`)
+}
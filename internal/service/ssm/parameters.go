@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Maximum number of parameter names in a single GetParameters or DeleteParameters request.
+	parametersBatchSize = 10
+
+	errCodeThrottlingException = "ThrottlingException"
+	errCodeTooManyUpdates      = "TooManyUpdates"
+)
+
+// @SDKResource("aws_ssm_parameters", name="Parameters")
+func resourceParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParametersCreate,
+		ReadWithoutTimeout:   resourceParametersRead,
+		UpdateWithoutTimeout: resourceParametersUpdate,
+		DeleteWithoutTimeout: resourceParametersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceParametersImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:      schema.TypeMap,
+				Required:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 2048),
+					validation.StringMatch(regexache.MustCompile(`^/`), "must begin with a forward slash (/)"),
+				),
+			},
+			"tier": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.ParameterTierStandard,
+				ValidateDiagFunc: enum.Validate[types.ParameterTier](),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.ParameterTypeString,
+				ValidateDiagFunc: enum.Validate[types.ParameterType](),
+			},
+			"versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func resourceParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Get("path").(string)
+	want := flex.ExpandStringValueMap(d.Get("parameters").(map[string]interface{}))
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	// Only write parameters whose values differ from any existing parameters.
+	have, err := findParametersByNames(ctx, conn, tfslices.ApplyToAll(tfmaps.Keys(want), func(v string) string {
+		return parameterNameFromPath(path, v)
+	}))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+	}
+
+	var puts []string
+	for k, v := range want {
+		if p, ok := have[parameterNameFromPath(path, k)]; !ok || aws.ToString(p.Value) != v || string(p.Type) != d.Get("type").(string) {
+			puts = append(puts, k)
+		}
+	}
+
+	if err := putParameters(ctx, conn, d, path, puts, want, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+	}
+
+	d.SetId(path)
+
+	if d.Get("exclusive").(bool) {
+		all, err := findParametersByPath(ctx, conn, path)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+		}
+
+		var deletes []string
+		for _, v := range all {
+			if _, ok := want[parameterKeyFromPath(path, aws.ToString(v.Name))]; !ok {
+				deletes = append(deletes, aws.ToString(v.Name))
+			}
+		}
+
+		if err := deleteParameters(ctx, conn, deletes, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting unmanaged SSM Parameters (%s): %s", path, err)
+		}
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+
+	var parameters []types.Parameter
+	if d.Get("exclusive").(bool) {
+		output, err := findParametersByPath(ctx, conn, path)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+		}
+
+		parameters = output
+	} else {
+		keys := tfmaps.Keys(d.Get("parameters").(map[string]interface{}))
+		output, err := findParametersByNames(ctx, conn, tfslices.ApplyToAll(keys, func(v string) string {
+			return parameterNameFromPath(path, v)
+		}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+		}
+
+		for _, v := range output {
+			parameters = append(parameters, v)
+		}
+	}
+
+	values, versions := make(map[string]string), make(map[string]int64)
+	parameterTypes := make(map[types.ParameterType]struct{})
+	for _, v := range parameters {
+		k := parameterKeyFromPath(path, aws.ToString(v.Name))
+		values[k] = aws.ToString(v.Value)
+		versions[k] = v.Version
+		parameterTypes[v.Type] = struct{}{}
+	}
+
+	d.Set("parameters", values)
+	d.Set("path", path)
+	// Parameters of mixed types can't be represented by the single type argument.
+	if len(parameterTypes) == 1 {
+		for v := range parameterTypes {
+			d.Set("type", v)
+		}
+	}
+	d.Set("versions", versions)
+
+	return diags
+}
+
+func resourceParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChanges("exclusive", "key_id", "parameters", "tier", "type") {
+		o, n := d.GetChange("parameters")
+		have := flex.ExpandStringValueMap(o.(map[string]interface{}))
+		want := flex.ExpandStringValueMap(n.(map[string]interface{}))
+		all := d.HasChanges("key_id", "tier", "type")
+
+		var puts []string
+		for k, v := range want {
+			if hv, ok := have[k]; all || !ok || hv != v {
+				puts = append(puts, k)
+			}
+		}
+
+		deletes := make(map[string]struct{})
+		for k := range have {
+			if _, ok := want[k]; !ok {
+				deletes[parameterNameFromPath(path, k)] = struct{}{}
+			}
+		}
+
+		// Unmanaged parameters are only in state if exclusive was already set.
+		if d.Get("exclusive").(bool) {
+			output, err := findParametersByPath(ctx, conn, path)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+			}
+
+			for _, v := range output {
+				if _, ok := want[parameterKeyFromPath(path, aws.ToString(v.Name))]; !ok {
+					deletes[aws.ToString(v.Name)] = struct{}{}
+				}
+			}
+		}
+
+		if err := putParameters(ctx, conn, d, path, puts, want, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+		}
+
+		if err := deleteParameters(ctx, conn, tfmaps.Keys(deletes), timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+		}
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	names := tfslices.ApplyToAll(tfmaps.Keys(d.Get("parameters").(map[string]interface{})), func(v string) string {
+		return parameterNameFromPath(path, v)
+	})
+
+	log.Printf("[DEBUG] Deleting SSM Parameters: %s", path)
+	if err := deleteParameters(ctx, conn, names, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", path, err)
+	}
+
+	return diags
+}
+
+func resourceParametersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Id()
+	parameters, err := findParametersByPath(ctx, conn, path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading SSM Parameters (%s): %w", path, err)
+	}
+
+	// Adopt every parameter under the path.
+	values := make(map[string]string)
+	for _, v := range parameters {
+		values[parameterKeyFromPath(path, aws.ToString(v.Name))] = aws.ToString(v.Value)
+	}
+
+	d.Set("exclusive", false)
+	d.Set("parameters", values)
+	d.Set("path", path)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parameterNameFromPath returns the fully qualified name of the parameter with the specified key under the specified path.
+func parameterNameFromPath(path, key string) string {
+	return strings.TrimSuffix(path, "/") + "/" + strings.TrimPrefix(key, "/")
+}
+
+// parameterKeyFromPath returns the key of the parameter with the specified fully qualified name relative to the specified path.
+func parameterKeyFromPath(path, name string) string {
+	return strings.TrimPrefix(name, strings.TrimSuffix(path, "/")+"/")
+}
+
+// putParameters writes the parameters with the specified keys one at a time, backing off while SSM throttles requests.
+func putParameters(ctx context.Context, conn *ssm.Client, d *schema.ResourceData, path string, keys []string, values map[string]string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	for _, k := range keys {
+		name := parameterNameFromPath(path, k)
+		input := &ssm.PutParameterInput{
+			Name:      aws.String(name),
+			Overwrite: aws.Bool(true),
+			Tier:      types.ParameterTier(d.Get("tier").(string)),
+			Type:      types.ParameterType(d.Get("type").(string)),
+			Value:     aws.String(values[k]),
+		}
+
+		if v, ok := d.GetOk("key_id"); ok && input.Type == types.ParameterTypeSecureString {
+			input.KeyId = aws.String(v.(string))
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, deadline.Remaining(), func() (interface{}, error) {
+			return conn.PutParameter(ctx, input)
+		}, errCodeThrottlingException, errCodeTooManyUpdates)
+
+		if err != nil {
+			return fmt.Errorf("putting SSM Parameter (%s): %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteParameters deletes the parameters with the specified names in batches, backing off while SSM throttles requests.
+func deleteParameters(ctx context.Context, conn *ssm.Client, names []string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	for _, chunk := range tfslices.Chunks(names, parametersBatchSize) {
+		input := &ssm.DeleteParametersInput{
+			Names: chunk,
+		}
+
+		// Names of parameters that don't exist are returned in InvalidParameters and are ignored.
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, deadline.Remaining(), func() (interface{}, error) {
+			return conn.DeleteParameters(ctx, input)
+		}, errCodeThrottlingException, errCodeTooManyUpdates)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findParametersByNames returns the parameters with the specified names, keyed by name.
+// Names of parameters that don't exist are omitted.
+func findParametersByNames(ctx context.Context, conn *ssm.Client, names []string) (map[string]types.Parameter, error) {
+	output := make(map[string]types.Parameter)
+
+	for _, chunk := range tfslices.Chunks(names, parametersBatchSize) {
+		input := &ssm.GetParametersInput{
+			Names:          chunk,
+			WithDecryption: aws.Bool(true),
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.GetParameters(ctx, input)
+		}, errCodeThrottlingException)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range outputRaw.(*ssm.GetParametersOutput).Parameters {
+			output[aws.ToString(v.Name)] = v
+		}
+	}
+
+	return output, nil
+}
+
+func findParametersByPath(ctx context.Context, conn *ssm.Client, path string) ([]types.Parameter, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+	var output []types.Parameter
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Parameters...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := fmt.Sprintf("/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(path, false, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.nested/key2", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "tier", string(types.ParameterTierStandard)),
+					resource.TestCheckResourceAttr(resourceName, "type", string(types.ParameterTypeString)),
+					resource.TestCheckResourceAttr(resourceName, "versions.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.key1", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParametersConfig_basic(path, false, "value1-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key1", "value1-updated"),
+					resource.TestCheckResourceAttr(resourceName, "versions.key1", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.nested/key2", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := fmt.Sprintf("/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(path, false, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameters(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_exclusive(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := fmt.Sprintf("/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_unmanaged(path),
+			},
+			{
+				// The unmanaged parameter is left alone in non-exclusive mode.
+				Config: testAccParametersConfig_withUnmanaged(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+				),
+			},
+			{
+				// The unmanaged parameter is deleted in exclusive mode.
+				Config: testAccParametersConfig_withUnmanaged(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					testAccCheckParametersNotExist(ctx, path+"/unmanaged"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_secureString(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := fmt.Sprintf("/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_type(path, string(types.ParameterTypeString)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", string(types.ParameterTypeString)),
+				),
+			},
+			{
+				Config: testAccParametersConfig_type(path, string(types.ParameterTypeSecureString)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "type", string(types.ParameterTypeSecureString)),
+					resource.TestCheckResourceAttr(resourceName, "versions.key1", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameters" {
+				continue
+			}
+
+			output, err := tfssm.FindParametersByNames(ctx, conn, testAccParametersNames(rs))
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("SSM Parameters %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		parameterNames := testAccParametersNames(rs)
+		output, err := tfssm.FindParametersByNames(ctx, conn, parameterNames)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output), len(parameterNames); got != want {
+			return fmt.Errorf("SSM Parameters (%s) count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersNotExist(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindParametersByNames(ctx, conn, []string{name})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) > 0 {
+			return fmt.Errorf("SSM Parameter %s still exists", name)
+		}
+
+		return nil
+	}
+}
+
+// testAccParametersNames returns the fully qualified names of the parameters in the specified resource's state.
+func testAccParametersNames(rs *terraform.ResourceState) []string {
+	var parameterNames []string
+
+	for k := range rs.Primary.Attributes {
+		if key, ok := strings.CutPrefix(k, "parameters."); ok && key != "%" {
+			parameterNames = append(parameterNames, rs.Primary.ID+"/"+key)
+		}
+	}
+
+	return parameterNames
+}
+
+func testAccParametersConfig_basic(path string, exclusive bool, value1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path      = %[1]q
+  exclusive = %[2]t
+
+  parameters = {
+    "key1"        = %[3]q
+    "nested/key2" = "value2"
+  }
+}
+`, path, exclusive, value1)
+}
+
+func testAccParametersConfig_unmanaged(path string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "unmanaged" {
+  name  = "%[1]s/unmanaged"
+  type  = "String"
+  value = "unmanaged"
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+`, path)
+}
+
+func testAccParametersConfig_withUnmanaged(path string, exclusive bool) string {
+	return acctest.ConfigCompose(testAccParametersConfig_unmanaged(path), fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path      = %[1]q
+  exclusive = %[2]t
+
+  parameters = {
+    "key1" = "value1"
+    "key2" = "value2"
+  }
+
+  depends_on = [aws_ssm_parameter.unmanaged]
+}
+`, path, exclusive))
+}
+
+func testAccParametersConfig_type(path, parameterType string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = %[1]q
+  type = %[2]q
+
+  parameters = {
+    "key1" = "value1"
+  }
+}
+`, path, parameterType)
+}
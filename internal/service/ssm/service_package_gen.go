@@ -43,6 +43,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceParameter,
 			TypeName: "aws_ssm_parameter",
 		},
+		{
+			Factory:  dataSourceParameterHistory,
+			TypeName: "aws_ssm_parameter_history",
+			Name:     "Parameter History",
+		},
 		{
 			Factory:  DataSourceParametersByPath,
 			TypeName: "aws_ssm_parameters_by_path",
@@ -106,6 +111,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Parameter",
 			},
 		},
+		{
+			Factory:  resourceParameterLabel,
+			TypeName: "aws_ssm_parameter_label",
+			Name:     "Parameter Label",
+		},
+		{
+			Factory:  resourceParameters,
+			TypeName: "aws_ssm_parameters",
+			Name:     "Parameters",
+		},
 		{
 			Factory:  resourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
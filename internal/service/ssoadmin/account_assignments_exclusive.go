@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Account Assignments Exclusive")
+func newAccountAssignmentsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &accountAssignmentsExclusiveResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	accountAssignmentsExclusiveIDPartCount = 3
+)
+
+type accountAssignmentsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *accountAssignmentsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_account_assignments_exclusive"
+}
+
+func (r *accountAssignmentsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_set_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"principal": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[accountAssignmentPrincipalModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"principal_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PrincipalType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *accountAssignmentsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data accountAssignmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	data.setID()
+
+	if err := data.syncAssignments(ctx, conn, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Account Assignments Exclusive (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *accountAssignmentsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data accountAssignmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	output, err := findAccountAssignmentsByThreePartKey(ctx, conn, data.InstanceARN.ValueString(), data.PermissionSetARN.ValueString(), data.TargetID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Account Assignments Exclusive (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	principals := tfslices.ApplyToAll(output, func(v awstypes.AccountAssignment) *accountAssignmentPrincipalModel {
+		return &accountAssignmentPrincipalModel{
+			PrincipalID:   types.StringPointerValue(v.PrincipalId),
+			PrincipalType: fwtypes.StringEnumValue(v.PrincipalType),
+		}
+	})
+
+	data.Principals = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, principals)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *accountAssignmentsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new accountAssignmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	if err := new.syncAssignments(ctx, conn, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SSO Account Assignments Exclusive (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *accountAssignmentsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func findAccountAssignmentsByThreePartKey(ctx context.Context, conn *ssoadmin.Client, instanceARN, permissionSetARN, accountID string) ([]awstypes.AccountAssignment, error) {
+	input := &ssoadmin.ListAccountAssignmentsInput{
+		AccountId:        aws.String(accountID),
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+	}
+
+	return findAccountAssignments(ctx, conn, input, tfslices.PredicateTrue[awstypes.AccountAssignment]())
+}
+
+type accountAssignmentsExclusiveResourceModel struct {
+	ID               types.String                                                    `tfsdk:"id"`
+	InstanceARN      fwtypes.ARN                                                     `tfsdk:"instance_arn"`
+	PermissionSetARN fwtypes.ARN                                                     `tfsdk:"permission_set_arn"`
+	Principals       fwtypes.SetNestedObjectValueOf[accountAssignmentPrincipalModel] `tfsdk:"principal"`
+	TargetID         types.String                                                    `tfsdk:"target_id"`
+	Timeouts         timeouts.Value                                                  `tfsdk:"timeouts"`
+}
+
+type accountAssignmentPrincipalModel struct {
+	PrincipalID   types.String                               `tfsdk:"principal_id"`
+	PrincipalType fwtypes.StringEnum[awstypes.PrincipalType] `tfsdk:"principal_type"`
+}
+
+func (data *accountAssignmentsExclusiveResourceModel) InitFromID() error {
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), accountAssignmentsExclusiveIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	var diags diag.Diagnostics
+
+	data.InstanceARN, diags = fwtypes.ARNValue(parts[0])
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	data.PermissionSetARN, diags = fwtypes.ARNValue(parts[1])
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	data.TargetID = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *accountAssignmentsExclusiveResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(intflex.FlattenResourceId([]string{data.InstanceARN.ValueString(), data.PermissionSetARN.ValueString(), data.TargetID.ValueString()}, accountAssignmentsExclusiveIDPartCount, false)))
+}
+
+// syncAssignments creates the configured assignments that do not exist and deletes existing assignments that are not configured.
+// Each assignment is waited on and all per-principal failures are returned.
+func (data *accountAssignmentsExclusiveResourceModel) syncAssignments(ctx context.Context, conn *ssoadmin.Client, timeout time.Duration) error {
+	instanceARN, permissionSetARN, accountID := data.InstanceARN.ValueString(), data.PermissionSetARN.ValueString(), data.TargetID.ValueString()
+
+	principals, diags := data.Principals.ToSlice(ctx)
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	existing, err := findAccountAssignmentsByThreePartKey(ctx, conn, instanceARN, permissionSetARN, accountID)
+
+	if err != nil {
+		return fmt.Errorf("reading SSO Account Assignments: %w", err)
+	}
+
+	key := func(principalID, principalType string) string {
+		return principalType + ":" + principalID
+	}
+
+	want := make(map[string]*accountAssignmentPrincipalModel, len(principals))
+	for _, v := range principals {
+		want[key(v.PrincipalID.ValueString(), v.PrincipalType.ValueString())] = v
+	}
+
+	have := make(map[string]awstypes.AccountAssignment, len(existing))
+	for _, v := range existing {
+		have[key(aws.ToString(v.PrincipalId), string(v.PrincipalType))] = v
+	}
+
+	var failures []error
+
+	for k, v := range want {
+		if _, ok := have[k]; ok {
+			continue
+		}
+
+		principalID, principalType := v.PrincipalID.ValueString(), v.PrincipalType.ValueString()
+		input := &ssoadmin.CreateAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceARN),
+			PermissionSetArn: aws.String(permissionSetARN),
+			PrincipalId:      aws.String(principalID),
+			PrincipalType:    awstypes.PrincipalType(principalType),
+			TargetId:         aws.String(accountID),
+			TargetType:       awstypes.TargetTypeAwsAccount,
+		}
+
+		output, err := conn.CreateAccountAssignment(ctx, input)
+
+		if err != nil {
+			failures = append(failures, fmt.Errorf("creating SSO Account Assignment for %s (%s): %w", principalType, principalID, err))
+			continue
+		}
+
+		if _, err := waitAccountAssignmentCreated(ctx, conn, instanceARN, aws.ToString(output.AccountAssignmentCreationStatus.RequestId), timeout); err != nil {
+			failures = append(failures, fmt.Errorf("waiting for SSO Account Assignment for %s (%s) create: %w", principalType, principalID, err))
+		}
+	}
+
+	for k, v := range have {
+		if _, ok := want[k]; ok {
+			continue
+		}
+
+		principalID, principalType := aws.ToString(v.PrincipalId), string(v.PrincipalType)
+		input := &ssoadmin.DeleteAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceARN),
+			PermissionSetArn: aws.String(permissionSetARN),
+			PrincipalId:      aws.String(principalID),
+			PrincipalType:    v.PrincipalType,
+			TargetId:         aws.String(accountID),
+			TargetType:       awstypes.TargetTypeAwsAccount,
+		}
+
+		output, err := conn.DeleteAccountAssignment(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			failures = append(failures, fmt.Errorf("deleting SSO Account Assignment for %s (%s): %w", principalType, principalID, err))
+			continue
+		}
+
+		if _, err := waitAccountAssignmentDeleted(ctx, conn, instanceARN, aws.ToString(output.AccountAssignmentDeletionStatus.RequestId), timeout); err != nil {
+			failures = append(failures, fmt.Errorf("waiting for SSO Account Assignment for %s (%s) delete: %w", principalType, principalID, err))
+		}
+	}
+
+	return errors.Join(failures...)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminAccountAssignmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_account_assignments_exclusive.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreUserName(t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsExclusiveConfig_user(os.Getenv("AWS_IDENTITY_STORE_USER_NAME"), rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "principal.*", map[string]string{
+						"principal_type": "USER",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccAccountAssignmentsExclusiveConfig_userAndGroup(os.Getenv("AWS_IDENTITY_STORE_USER_NAME"), os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME"), rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "principal.*", map[string]string{
+						"principal_type": "GROUP",
+					}),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsExclusiveConfig_user(os.Getenv("AWS_IDENTITY_STORE_USER_NAME"), rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignmentsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_account_assignments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreUserName(t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsExclusiveConfig_outOfBand(os.Getenv("AWS_IDENTITY_STORE_USER_NAME"), os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME"), rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExclusiveCount(ctx, resourceName, 2),
+				),
+				// The exclusive resource detects the assignment it doesn't manage.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountAssignmentsExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		output, err := tfssoadmin.FindAccountAssignmentsByThreePartKey(ctx, conn, rs.Primary.Attributes["instance_arn"], rs.Primary.Attributes["permission_set_arn"], rs.Primary.Attributes["target_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("SSO Account Assignments (%s): got %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountAssignmentsExclusiveConfig_base(userName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentConfig_base(rName), fmt.Sprintf(`
+data "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    unique_attribute {
+      attribute_path  = "UserName"
+      attribute_value = %[1]q
+    }
+  }
+}
+`, userName))
+}
+
+func testAccAccountAssignmentsExclusiveConfig_group(groupName string) string {
+	return fmt.Sprintf(`
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    unique_attribute {
+      attribute_path  = "DisplayName"
+      attribute_value = %[1]q
+    }
+  }
+}
+`, groupName)
+}
+
+func testAccAccountAssignmentsExclusiveConfig_user(userName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsExclusiveConfig_base(userName, rName), `
+resource "aws_ssoadmin_account_assignments_exclusive" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_id          = data.aws_caller_identity.current.account_id
+
+  principal {
+    principal_id   = data.aws_identitystore_user.test.user_id
+    principal_type = "USER"
+  }
+}
+`)
+}
+
+func testAccAccountAssignmentsExclusiveConfig_userAndGroup(userName, groupName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsExclusiveConfig_base(userName, rName), testAccAccountAssignmentsExclusiveConfig_group(groupName), `
+resource "aws_ssoadmin_account_assignments_exclusive" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_id          = data.aws_caller_identity.current.account_id
+
+  principal {
+    principal_id   = data.aws_identitystore_user.test.user_id
+    principal_type = "USER"
+  }
+
+  principal {
+    principal_id   = data.aws_identitystore_group.test.group_id
+    principal_type = "GROUP"
+  }
+}
+`)
+}
+
+func testAccAccountAssignmentsExclusiveConfig_outOfBand(userName, groupName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsExclusiveConfig_user(userName, rName), testAccAccountAssignmentsExclusiveConfig_group(groupName), `
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_type        = "AWS_ACCOUNT"
+  target_id          = data.aws_caller_identity.current.account_id
+  principal_type     = "GROUP"
+  principal_id       = data.aws_identitystore_group.test.group_id
+
+  depends_on = [aws_ssoadmin_account_assignments_exclusive.test]
+}
+`)
+}
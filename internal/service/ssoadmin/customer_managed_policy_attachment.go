@@ -30,6 +30,7 @@ func ResourceCustomerManagedPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomerManagedPolicyAttachmentCreate,
 		ReadWithoutTimeout:   resourceCustomerManagedPolicyAttachmentRead,
+		UpdateWithoutTimeout: schema.NoopContext,
 		DeleteWithoutTimeout: resourceCustomerManagedPolicyAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -77,6 +78,10 @@ func ResourceCustomerManagedPolicyAttachment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"skip_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -105,9 +110,11 @@ func resourceCustomerManagedPolicyAttachmentCreate(ctx context.Context, d *schem
 
 	d.SetId(id)
 
-	// After the policy has been attached to the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		// After the policy has been attached to the permission set, provision in all accounts that use this permission set.
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourceCustomerManagedPolicyAttachmentRead(ctx, d, meta)...)
@@ -172,9 +179,11 @@ func resourceCustomerManagedPolicyAttachmentDelete(ctx context.Context, d *schem
 		return sdkdiag.AppendErrorf(diags, "deleting SSO Customer Managed Policy Attachment (%s): %s", d.Id(), err)
 	}
 
-	// After the policy has been detached from the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		// After the policy has been detached from the permission set, provision in all accounts that use this permission set.
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
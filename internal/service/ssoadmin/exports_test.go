@@ -5,12 +5,15 @@ package ssoadmin
 
 // Exports for use in tests only.
 var (
+	ResourceAccountAssignmentsExclusive        = newAccountAssignmentsExclusiveResource
 	ResourceApplication                        = newResourceApplication
 	ResourceApplicationAssignment              = newResourceApplicationAssignment
 	ResourceApplicationAssignmentConfiguration = newResourceApplicationAssignmentConfiguration
 	ResourceApplicationAccessScope             = newResourceApplicationAccessScope
+	ResourcePermissionSetProvisioning          = newPermissionSetProvisioningResource
 	ResourceTrustedTokenIssuer                 = newResourceTrustedTokenIssuer
 
+	FindAccountAssignmentsByThreePartKey       = findAccountAssignmentsByThreePartKey
 	FindApplicationByID                        = findApplicationByID
 	FindApplicationAssignmentByID              = findApplicationAssignmentByID
 	FindApplicationAssignmentConfigurationByID = findApplicationAssignmentConfigurationByID
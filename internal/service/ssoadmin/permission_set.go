@@ -92,6 +92,10 @@ func ResourcePermissionSet() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 100),
 				Default:      "PT1H",
 			},
+			"skip_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -213,9 +217,11 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 			return sdkdiag.AppendErrorf(diags, "updating SSO Permission Set (%s): %s", d.Id(), err)
 		}
 
-		// Re-provision ALL accounts after making the above changes
-		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
+		if _, ok := d.GetOk("skip_provisioning"); !ok {
+			// Re-provision ALL accounts after making the above changes
+			if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
 		}
 	}
 
@@ -64,6 +64,10 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"skip_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -72,6 +76,11 @@ func resourcePermissionSetInlinePolicyPut(ctx context.Context, d *schema.Resourc
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
 
+	// Changing only skip_provisioning requires no API calls.
+	if !d.IsNewResource() && !d.HasChange("inline_policy") {
+		return append(diags, resourcePermissionSetInlinePolicyRead(ctx, d, meta)...)
+	}
+
 	policy, err := structure.NormalizeJsonString(d.Get("inline_policy").(string))
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -93,9 +102,11 @@ func resourcePermissionSetInlinePolicyPut(ctx context.Context, d *schema.Resourc
 
 	d.SetId(fmt.Sprintf("%s,%s", permissionSetARN, instanceARN))
 
-	// (Re)provision ALL accounts after making the above changes.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		// (Re)provision ALL accounts after making the above changes.
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourcePermissionSetInlinePolicyRead(ctx, d, meta)...)
@@ -158,9 +169,11 @@ func resourcePermissionSetInlinePolicyDelete(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "deleting SSO Permission Set (%s) Inline Policy: %s", permissionSetARN, err)
 	}
 
-	// (Re)provision ALL accounts after making the above changes.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		// (Re)provision ALL accounts after making the above changes.
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Permission Set Provisioning")
+func newPermissionSetProvisioningResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &permissionSetProvisioningResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	permissionSetProvisioningIDPartCount = 2
+)
+
+type permissionSetProvisioningResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *permissionSetProvisioningResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_permission_set_provisioning"
+}
+
+func (r *permissionSetProvisioningResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_set_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *permissionSetProvisioningResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data permissionSetProvisioningResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	data.setID()
+
+	response.Diagnostics.Append(data.provision(ctx, conn, r.CreateTimeout(ctx, data.Timeouts))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *permissionSetProvisioningResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data permissionSetProvisioningResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	_, err := FindPermissionSet(ctx, conn, data.PermissionSetARN.ValueString(), data.InstanceARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Permission Set (%s)", data.PermissionSetARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *permissionSetProvisioningResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new permissionSetProvisioningResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	// Any change to the targets or triggers re-provisions the permission set.
+	response.Diagnostics.Append(new.provision(ctx, conn, r.UpdateTimeout(ctx, new.Timeouts))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+type permissionSetProvisioningResourceModel struct {
+	ID               types.String                     `tfsdk:"id"`
+	InstanceARN      fwtypes.ARN                      `tfsdk:"instance_arn"`
+	PermissionSetARN fwtypes.ARN                      `tfsdk:"permission_set_arn"`
+	TargetIDs        fwtypes.SetValueOf[types.String] `tfsdk:"target_ids"`
+	Timeouts         timeouts.Value                   `tfsdk:"timeouts"`
+	Triggers         fwtypes.MapValueOf[types.String] `tfsdk:"triggers"`
+}
+
+func (data *permissionSetProvisioningResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(intflex.FlattenResourceId([]string{data.InstanceARN.ValueString(), data.PermissionSetARN.ValueString()}, permissionSetProvisioningIDPartCount, false)))
+}
+
+// provision provisions the permission set to each target account, or to all provisioned accounts if no targets are configured.
+// A diagnostic is returned for each account that fails to provision.
+func (data *permissionSetProvisioningResourceModel) provision(ctx context.Context, conn *ssoadmin.Client, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	instanceARN, permissionSetARN := data.InstanceARN.ValueString(), data.PermissionSetARN.ValueString()
+
+	if data.TargetIDs.IsNull() {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("provisioning SSO Permission Set (%s) to all provisioned accounts", permissionSetARN), err.Error())
+		}
+
+		return diags
+	}
+
+	for _, accountID := range fwflex.ExpandFrameworkStringValueSet(ctx, data.TargetIDs) {
+		if err := provisionPermissionSetToAccount(ctx, conn, permissionSetARN, instanceARN, accountID, timeout); err != nil {
+			diags.AddAttributeError(path.Root("target_ids"), fmt.Sprintf("provisioning SSO Permission Set (%s) to account (%s)", permissionSetARN, accountID), err.Error())
+		}
+	}
+
+	return diags
+}
+
+func provisionPermissionSetToAccount(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN, accountID string, timeout time.Duration) error {
+	input := &ssoadmin.ProvisionPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+		TargetId:         aws.String(accountID),
+		TargetType:       awstypes.ProvisionTargetTypeAwsAccount,
+	}
+
+	output, err := conn.ProvisionPermissionSet(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitPermissionSetProvisioned(ctx, conn, instanceARN, aws.ToString(output.PermissionSetProvisioningStatus.RequestId), timeout); err != nil {
+		return fmt.Errorf("waiting for provision: %w", err)
+	}
+
+	return nil
+}
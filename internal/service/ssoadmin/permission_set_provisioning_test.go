@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminPermissionSetProvisioning_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_permission_set_provisioning.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningConfig_basic(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "one"),
+				),
+			},
+			{
+				Config: testAccPermissionSetProvisioningConfig_basic(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "two"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionSetProvisioning_allProvisionedAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_permission_set_provisioning.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningConfig_allProvisionedAccounts(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "target_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionSetProvisioning_skipProvisioning(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_permission_set_provisioning.test"
+	attachmentResourceName := "aws_ssoadmin_managed_policy_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningConfig_skipProvisioning(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(attachmentResourceName, "skip_provisioning", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "triggers.managed_policy_arn", attachmentResourceName, "managed_policy_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionSetProvisioningConfig_basic(rName, version string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssoadmin_permission_set_provisioning" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_ids         = [data.aws_caller_identity.current.account_id]
+
+  triggers = {
+    version = %[1]q
+  }
+}
+`, version))
+}
+
+func testAccPermissionSetProvisioningConfig_allProvisionedAccounts(rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentConfig_base(rName), `
+resource "aws_ssoadmin_permission_set_provisioning" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+`)
+}
+
+func testAccPermissionSetProvisioningConfig_skipProvisioning(rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentConfig_base(rName), `
+data "aws_partition" "current" {}
+
+resource "aws_ssoadmin_managed_policy_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  managed_policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AlexaForBusinessDeviceSetup"
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  skip_provisioning  = true
+}
+
+resource "aws_ssoadmin_permission_set_provisioning" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_ids         = [data.aws_caller_identity.current.account_id]
+
+  triggers = {
+    managed_policy_arn = aws_ssoadmin_managed_policy_attachment.test.managed_policy_arn
+  }
+}
+`)
+}
@@ -29,6 +29,7 @@ func ResourcePermissionsBoundaryAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePermissionsBoundaryAttachmentCreate,
 		ReadWithoutTimeout:   resourcePermissionsBoundaryAttachmentRead,
+		UpdateWithoutTimeout: schema.NoopContext,
 		DeleteWithoutTimeout: resourcePermissionsBoundaryAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -93,6 +94,10 @@ func ResourcePermissionsBoundaryAttachment() *schema.Resource {
 					},
 				},
 			},
+			"skip_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -119,9 +124,11 @@ func resourcePermissionsBoundaryAttachmentCreate(ctx context.Context, d *schema.
 
 	d.SetId(id)
 
-	// After the policy has been attached to the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		// After the policy has been attached to the permission set, provision in all accounts that use this permission set.
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourcePermissionsBoundaryAttachmentRead(ctx, d, meta)...)
@@ -181,9 +188,11 @@ func resourcePermissionsBoundaryAttachmentDelete(ctx context.Context, d *schema.
 		return sdkdiag.AppendErrorf(diags, "deleting SSO Permissions Boundary Attachment (%s): %s", d.Id(), err)
 	}
 
-	// After the policy has been detached from the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		// After the policy has been detached from the permission set, provision in all accounts that use this permission set.
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
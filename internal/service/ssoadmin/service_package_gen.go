@@ -35,6 +35,14 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAccountAssignmentsExclusiveResource,
+			Name:    "Account Assignments Exclusive",
+		},
+		{
+			Factory: newPermissionSetProvisioningResource,
+			Name:    "Permission Set Provisioning",
+		},
 		{
 			Factory: newResourceApplication,
 			Name:    "Application",
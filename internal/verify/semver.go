@@ -4,6 +4,8 @@
 package verify
 
 import (
+	"fmt"
+
 	gversion "github.com/hashicorp/go-version"
 )
 
@@ -45,3 +47,48 @@ func parseVersions(s1, s2 string) (*gversion.Version, *gversion.Version, error)
 	}
 	return v1, v2, nil
 }
+
+// SemVerConstraintsSatisfied returns whether or not the version string satisfies the version constraints string
+// (e.g. ">= 1.15, < 2.0" or "~> 1.15.0"), see https://developer.hashicorp.com/terraform/language/expressions/version-constraints.
+// Pre-release and build metadata in the version string are ignored by constraints without a pre-release, so that
+// e.g. "v1.15.1-eksbuild.1" satisfies ">= 1.15", and are compared by constraints with one, e.g. "= v1.15.1-eksbuild.1".
+func SemVerConstraintsSatisfied(version, constraints string) bool {
+	v, err := gversion.NewVersion(version)
+
+	if err != nil {
+		return false
+	}
+
+	cs, err := gversion.NewConstraint(constraints)
+
+	if err != nil {
+		return false
+	}
+
+	for _, c := range cs {
+		if c.Prerelease() {
+			if !c.Check(v) {
+				return false
+			}
+		} else if !c.Check(v.Core()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidSemVerConstraints validates that a string is a valid set of version constraints.
+func ValidSemVerConstraints(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := gversion.NewConstraint(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) is not a valid version constraint: %w", k, value, err))
+	}
+
+	return
+}
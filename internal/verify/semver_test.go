@@ -48,3 +48,60 @@ func TestSemVerGreaterThanOrEqual(t *testing.T) {
 		}
 	}
 }
+
+func TestSemVerConstraintsSatisfied(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		version     string
+		constraints string
+		satisfied   bool
+	}{
+		{"1.15.0", ">= 1.15", true},
+		{"1.14.9", ">= 1.15", false},
+		{"v1.15.1-eksbuild.1", ">= 1.15, < 2.0", true},
+		{"v1.15.1-eksbuild.1", "~> 1.15.0", true},
+		{"v1.16.0-eksbuild.1", "~> 1.15.0", false},
+		{"v2.0.0-eksbuild.1", ">= 1.15, < 2.0", false},
+		{"v1.15.1-eksbuild.1", "= v1.15.1-eksbuild.1", true},
+		{"v1.15.1-eksbuild.2", "= v1.15.1-eksbuild.1", false},
+		{"v1.15.1-eksbuild.2", ">= v1.15.1-eksbuild.1, < 2.0", true},
+		{"v1.15.1-eksbuild.1", "!= v1.15.1-eksbuild.1", false},
+		{"abc", ">= 1.0", false},
+		{"1.0", "abc", false},
+	} {
+		satisfied := SemVerConstraintsSatisfied(tc.version, tc.constraints)
+		if tc.satisfied != satisfied {
+			t.Fatalf("SemVerConstraintsSatisfied(%q, %q) should be: %t", tc.version, tc.constraints, tc.satisfied)
+		}
+	}
+}
+
+func TestValidSemVerConstraints(t *testing.T) {
+	t.Parallel()
+
+	validConstraints := []string{
+		">= 1.15",
+		"~> 1.15.0",
+		">= 1.15, < 2.0",
+		"= v1.15.1-eksbuild.1",
+	}
+	for _, v := range validConstraints {
+		_, errors := ValidSemVerConstraints(v, "version_constraint")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid version constraints: %q", v, errors)
+		}
+	}
+
+	invalidConstraints := []string{
+		"",
+		"abc",
+		">>= 1.0",
+	}
+	for _, v := range invalidConstraints {
+		_, errors := ValidSemVerConstraints(v, "version_constraint")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid version constraints", v)
+		}
+	}
+}